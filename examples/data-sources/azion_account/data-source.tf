@@ -0,0 +1,5 @@
+data "azion_account" "current" {}
+
+output "account_name" {
+  value = data.azion_account.current.name
+}
@@ -13,6 +13,12 @@ data "azion_connector" "by_id" {
 #   id = azion_connector.example.connector.id
 # }
 
+# Read a single connector by name
+# The name must match exactly one connector
+data "azion_connector" "by_name" {
+  name = "My HTTP Connector"
+}
+
 # =====================================================
 # OUTPUTS
 # =====================================================
@@ -1,3 +1,7 @@
 data "azion_firewall_main_setting" "example" {
   firewall_id = 12464
 }
+
+data "azion_firewall_main_setting" "by_name" {
+  name = "my-firewall"
+}
@@ -1,3 +1,7 @@
 data "azion_function" "example" {
   id = "1234567890"
 }
+
+data "azion_function" "by_name" {
+  name = "my-function"
+}
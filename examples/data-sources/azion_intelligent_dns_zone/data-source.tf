@@ -1,3 +1,18 @@
 data "azion_intelligent_dns_zone" "examples" {
   id = "1234"
-}
\ No newline at end of file
+}
+
+data "azion_intelligent_dns_zone" "by_domain" {
+  domain = "example.com"
+}
+
+resource "azion_intelligent_dns_record" "www" {
+  zone_id = data.azion_intelligent_dns_zone.by_domain.id
+  record = {
+    type   = "A"
+    name   = "www"
+    rdata  = ["192.0.2.1"]
+    policy = "simple"
+    ttl    = 300
+  }
+}
@@ -1,3 +1,7 @@
 data "azion_network_list" "example" {
   id = 1235
 }
+
+data "azion_network_list" "by_name" {
+  name = "office"
+}
@@ -0,0 +1,4 @@
+data "azion_storage_objects" "example" {
+  bucket = "my-site"
+  prefix = "css/"
+}
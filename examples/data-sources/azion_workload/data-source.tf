@@ -1,3 +1,7 @@
 data "azion_workload" "example" {
   id = "12345"
 }
+
+data "azion_workload" "by_name" {
+  name = "my-workload"
+}
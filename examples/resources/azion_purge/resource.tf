@@ -0,0 +1,27 @@
+resource "azion_storage_object" "index" {
+  bucket       = "my-site"
+  key          = "index.html"
+  source       = "${path.module}/site/index.html"
+  content_type = "text/html"
+}
+
+# Purge the page from the edge cache whenever the object changes.
+resource "azion_purge" "index" {
+  type    = "url"
+  targets = ["www.example.com/", "www.example.com/index.html"]
+
+  triggers = {
+    checksum = azion_storage_object.index.checksum
+  }
+}
+
+# Purge every image from the tiered cache on each new release.
+resource "azion_purge" "images" {
+  type    = "wildcard"
+  targets = ["www.example.com/images/*"]
+  layer   = "tiered_cache"
+
+  triggers = {
+    release = var.release
+  }
+}
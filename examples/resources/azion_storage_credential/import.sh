@@ -0,0 +1 @@
+terraform import azion_storage_credential.example 1234
@@ -0,0 +1,23 @@
+resource "azion_bucket" "assets" {
+  bucket = {
+    name             = "my-assets"
+    workloads_access = "read_only"
+  }
+}
+
+resource "azion_storage_credential" "deploy" {
+  name         = "deploy-pipeline"
+  buckets      = [azion_bucket.assets.bucket.name]
+  capabilities = ["listFiles", "readFiles", "writeFiles", "deleteFiles"]
+  expiration   = "2026-12-31T23:59:59Z"
+}
+
+output "deploy_access_key" {
+  value     = azion_storage_credential.deploy.access_key
+  sensitive = true
+}
+
+output "deploy_secret_key" {
+  value     = azion_storage_credential.deploy.secret_key
+  sensitive = true
+}
@@ -0,0 +1 @@
+terraform import azion_storage_directory_sync.site my-site/public/
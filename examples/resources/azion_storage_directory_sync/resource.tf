@@ -0,0 +1,13 @@
+resource "azion_bucket" "site" {
+  bucket = {
+    name             = "my-site"
+    workloads_access = "read_only"
+  }
+}
+
+resource "azion_storage_directory_sync" "site" {
+  bucket         = azion_bucket.site.bucket.name
+  prefix         = "public/"
+  source_dir     = "${path.module}/dist"
+  delete_removed = true
+}
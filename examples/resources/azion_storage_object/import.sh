@@ -0,0 +1 @@
+terraform import azion_storage_object.example my-site/css/site.css
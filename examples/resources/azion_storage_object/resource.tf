@@ -0,0 +1,22 @@
+resource "azion_bucket" "site" {
+  bucket = {
+    name             = "my-site"
+    workloads_access = "read_only"
+  }
+}
+
+# Upload a file
+resource "azion_storage_object" "stylesheet" {
+  bucket       = azion_bucket.site.bucket.name
+  key          = "css/site.css"
+  source       = "${path.module}/site/css/site.css"
+  content_type = "text/css"
+}
+
+# Upload inline content
+resource "azion_storage_object" "index" {
+  bucket       = azion_bucket.site.bucket.name
+  key          = "index.html"
+  content      = "<html><body>Hello</body></html>"
+  content_type = "text/html"
+}
@@ -0,0 +1 @@
+terraform import azion_variable.example 1234
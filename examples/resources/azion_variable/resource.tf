@@ -0,0 +1,10 @@
+resource "azion_variable" "region" {
+  key   = "REGION"
+  value = "us-east"
+}
+
+resource "azion_variable" "api_token" {
+  key    = "API_TOKEN"
+  value  = var.api_token
+  secret = true
+}
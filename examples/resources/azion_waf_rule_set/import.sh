@@ -1 +1 @@
-terraform import azion_waf_rule_set.example 6061
\ No newline at end of file
+terraform import azion_waf_rule_set.example 1234/6061
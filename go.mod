@@ -3,16 +3,18 @@ module github.com/aziontech/terraform-provider-azion
 go 1.24.0
 
 require (
-	github.com/aziontech/azionapi-go-sdk v0.139.0
+	github.com/BurntSushi/toml v1.4.0
 	github.com/aziontech/azionapi-v4-go-sdk-dev v0.251.0
 	github.com/hashicorp/go-changelog v0.0.0-20230630083008-522d403eacf1
 	github.com/hashicorp/terraform-plugin-docs v0.16.0
 	github.com/hashicorp/terraform-plugin-framework v1.4.2
+	github.com/hashicorp/terraform-plugin-framework-timeouts v0.4.1
 	github.com/hashicorp/terraform-plugin-framework-validators v0.12.0
 	github.com/hashicorp/terraform-plugin-go v0.19.0
 	github.com/hashicorp/terraform-plugin-log v0.9.0
 	github.com/hashicorp/terraform-plugin-mux v0.12.0
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.29.0
+	golang.org/x/time v0.12.0
 )
 
 require (
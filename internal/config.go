@@ -1,131 +1,148 @@
 package provider
 
 import (
-	"os"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
 
-	"github.com/aziontech/azionapi-go-sdk/idns"
-	"github.com/aziontech/azionapi-go-sdk/waf"
-
-	"github.com/aziontech/azionapi-go-sdk/digital_certificates"
-	"github.com/aziontech/azionapi-go-sdk/edgeapplications"
-	"github.com/aziontech/azionapi-go-sdk/edgefirewall"
-	"github.com/aziontech/azionapi-go-sdk/edgefunctions"
-	"github.com/aziontech/azionapi-go-sdk/edgefunctionsinstance_edgefirewall"
-	"github.com/aziontech/azionapi-go-sdk/networklist"
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
-	edgeapi "github.com/aziontech/azionapi-v4-go-sdk-dev/edge-api"
+	"github.com/aziontech/terraform-provider-azion/internal/consts"
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"golang.org/x/time/rate"
 )
 
 type apiClient struct {
-	idnsConfig *idns.Configuration
-	idnsApi    *idns.APIClient
+	apiConfig *azionapi.Configuration
+	api       *azionapi.APIClient
 
-	edgefunctionsConfig *edgefunctions.Configuration
-	edgefunctionsApi    *edgefunctions.APIClient
+	// limiter paces the requests of the client above.
+	limiter *rate.Limiter
 
-	//TODO: remove this
-	edgeApplicationsApi *edgeapplications.APIClient
+	// namePrefix is the provider's default_name_prefix.
+	namePrefix string
 
-	// V4 SDK (azion-api) - preferred for new implementations
-	apiConfig *azionapi.Configuration
-	api       *azionapi.APIClient
+	// account is the account the API token belongs to. It is nil when the
+	// token was not validated in Configure.
+	account *accountInfo
+}
 
-	// Legacy V4 SDK (edge-api) - kept for backward compatibility
-	edgeConfig *edgeapi.Configuration
-	edgeApi    *edgeapi.APIClient
+// accountInfo identifies the account behind the API token.
+type accountInfo struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	// Type is the client type of the account: Brand, Reseller, Organization
+	// or Workspace.
+	Type string `json:"type"`
+}
 
-	digitalCertificatesConfig *digital_certificates.Configuration
-	digitalCertificatesApi    *digital_certificates.APIClient
+// clientConfig holds the provider settings used to build the API client.
+type clientConfig struct {
+	MaxRetries    int
+	MinBackoff    time.Duration
+	MaxBackoff    time.Duration
+	RetryOnStatus []int
 
-	networkListConfig *networklist.Configuration
-	networkListApi    *networklist.APIClient
+	// RequestsPerSecond of zero disables client-side rate limiting.
+	RequestsPerSecond float64
+	Burst             int
 
-	edgefirewallConfig *edgefirewall.Configuration
-	edgeFirewallApi    *edgefirewall.APIClient
+	// APIURL is the base URL of the v4 API.
+	APIURL string
 
-	edgefunctionsinstanceEdgefirewallConfig *edgefunctionsinstance_edgefirewall.Configuration
-	edgefunctionsinstanceEdgefirewallApi    *edgefunctionsinstance_edgefirewall.APIClient
+	// NamePrefix is prepended to the name of the resources created.
+	NamePrefix string
 
-	wafConfig *waf.Configuration
-	wafApi    *waf.APIClient
+	// Transport sends the requests of the client. Nil uses
+	// http.DefaultTransport.
+	Transport http.RoundTripper
 }
 
-func Client(APIToken string, userAgent string) *apiClient {
-	client := &apiClient{
-		idnsConfig:                              idns.NewConfiguration(),
-		edgefunctionsConfig:                     edgefunctions.NewConfiguration(),
-		apiConfig:                               azionapi.NewConfiguration(),
-		edgeConfig:                              edgeapi.NewConfiguration(),
-		digitalCertificatesConfig:               digital_certificates.NewConfiguration(),
-		networkListConfig:                       networklist.NewConfiguration(),
-		edgefirewallConfig:                      edgefirewall.NewConfiguration(),
-		edgefunctionsinstanceEdgefirewallConfig: edgefunctionsinstance_edgefirewall.NewConfiguration(),
-		wafConfig:                               waf.NewConfiguration(),
-	}
-
-	envApiEntrypoint := os.Getenv("AZION_API_ENTRYPOINT")
-	v4url := "https://api.azion.com/v4"
-
-	// Always set v4 URL for V4 SDKs (azion-api and edge-api)
-	client.apiConfig.Servers[0].URL = v4url
-	client.edgeConfig.Servers[0].URL = v4url
-
-	if envApiEntrypoint != "" {
-		client.idnsConfig.Servers[0].URL = envApiEntrypoint
-		client.edgefunctionsConfig.Servers[0].URL = envApiEntrypoint
-		client.digitalCertificatesConfig.Servers[0].URL = envApiEntrypoint
-		client.edgefirewallConfig.Servers[0].URL = envApiEntrypoint
-		client.edgefunctionsinstanceEdgefirewallConfig.Servers[0].URL = envApiEntrypoint
-		client.networkListConfig.Servers[0].URL = envApiEntrypoint
-		client.wafConfig.Servers[0].URL = envApiEntrypoint
+func newClientConfig() clientConfig {
+	return clientConfig{
+		MaxRetries:    utils.DefaultMaxRetries,
+		MinBackoff:    utils.DefaultMinBackoff,
+		MaxBackoff:    utils.DefaultMaxBackoff,
+		RetryOnStatus: utils.DefaultRetryOnStatus,
+		APIURL:        consts.APIURLProduction,
 	}
+}
 
-	client.idnsConfig.AddDefaultHeader("Authorization", "token "+APIToken)
-	client.idnsConfig.AddDefaultHeader("Accept", "application/json; version=3")
-	client.idnsConfig.UserAgent = userAgent
-	client.idnsApi = idns.NewAPIClient(client.idnsConfig)
+func Client(APIToken string, userAgent string, config clientConfig) *apiClient {
+	client := &apiClient{
+		apiConfig: azionapi.NewConfiguration(),
+		limiter:   utils.NewRateLimiter(config.RequestsPerSecond, config.Burst),
 
-	client.edgefunctionsConfig.AddDefaultHeader("Authorization", "token "+APIToken)
-	client.edgefunctionsConfig.AddDefaultHeader("Accept", "application/json; version=3")
-	client.edgefunctionsConfig.UserAgent = userAgent
-	client.edgefunctionsApi = edgefunctions.NewAPIClient(client.edgefunctionsConfig)
+		namePrefix: config.NamePrefix,
+	}
 
-	// V4 SDK (azion-api) - preferred for new implementations
+	// Transient failures are retried in the transport instead of in each
+	// resource. The limiter sits below the retry transport so that retries
+	// are paced as well, and the logging transport sits below both so that
+	// each attempt is logged with its own latency.
+	transport := config.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	retryTransport := utils.NewRetryTransport(utils.NewRateLimitTransport(utils.NewLoggingTransport(transport), client.limiter))
+	retryTransport.MaxRetries = config.MaxRetries
+	retryTransport.MinBackoff = config.MinBackoff
+	retryTransport.MaxBackoff = config.MaxBackoff
+	retryTransport.RetryOnStatus = config.RetryOnStatus
+
+	client.apiConfig.HTTPClient = &http.Client{
+		Transport: retryTransport,
+	}
+	client.apiConfig.Servers[0].URL = config.APIURL
 	client.apiConfig.AddDefaultHeader("Authorization", "token "+APIToken)
-	client.apiConfig.AddDefaultHeader("Accept", "application/json; version=3")
 	client.apiConfig.UserAgent = userAgent
 	client.api = azionapi.NewAPIClient(client.apiConfig)
 
-	// Legacy V4 SDK (edge-api) - kept for backward compatibility
-	client.edgeConfig.AddDefaultHeader("Authorization", "token "+APIToken)
-	client.edgeConfig.AddDefaultHeader("Accept", "application/json; version=3")
-	client.edgeConfig.UserAgent = userAgent
-	client.edgeApi = edgeapi.NewAPIClient(client.edgeConfig)
-
-	client.digitalCertificatesConfig.AddDefaultHeader("Authorization", "token "+APIToken)
-	client.digitalCertificatesConfig.AddDefaultHeader("Accept", "application/json; version=3")
-	client.digitalCertificatesConfig.UserAgent = userAgent
-	client.digitalCertificatesApi = digital_certificates.NewAPIClient(client.digitalCertificatesConfig)
-
-	client.networkListConfig.AddDefaultHeader("Authorization", "token "+APIToken)
-	client.networkListConfig.AddDefaultHeader("Accept", "application/json; version=3")
-	client.networkListConfig.UserAgent = userAgent
-	client.networkListApi = networklist.NewAPIClient(client.networkListConfig)
-
-	client.edgefirewallConfig.AddDefaultHeader("Authorization", "token "+APIToken)
-	client.edgefirewallConfig.AddDefaultHeader("Accept", "application/json; version=3")
-	client.edgefirewallConfig.UserAgent = userAgent
-	client.edgeFirewallApi = edgefirewall.NewAPIClient(client.edgefirewallConfig)
-
-	client.edgefunctionsinstanceEdgefirewallConfig.AddDefaultHeader("Authorization", "token "+APIToken)
-	client.edgefunctionsinstanceEdgefirewallConfig.AddDefaultHeader("Accept", "application/json; version=3")
-	client.edgefunctionsinstanceEdgefirewallConfig.UserAgent = userAgent
-	client.edgefunctionsinstanceEdgefirewallApi = edgefunctionsinstance_edgefirewall.NewAPIClient(client.edgefunctionsinstanceEdgefirewallConfig)
-
-	client.wafConfig.AddDefaultHeader("Authorization", "token "+APIToken)
-	client.wafConfig.AddDefaultHeader("Accept", "application/json; version=3")
-	client.wafConfig.UserAgent = userAgent
-	client.wafApi = waf.NewAPIClient(client.wafConfig)
-
 	return client
 }
+
+// prefixName returns the name sent to the API for a configured name.
+func (c *apiClient) prefixName(name string) string {
+	return c.namePrefix + name
+}
+
+// unprefixName returns the configured name for a name read from the API, so
+// that the prefix does not show up as a difference in plans. Names without
+// the prefix are returned as is, which plans an update when the prefix
+// changes.
+func (c *apiClient) unprefixName(name string) string {
+	return strings.TrimPrefix(name, c.namePrefix)
+}
+
+// loggedAccount retrieves the account the API token belongs to.
+func (c *apiClient) loggedAccount(ctx context.Context) (*accountInfo, *http.Response, error) {
+	accountResponse, response, err := c.api.AccountsLoggedInAPI.RetrieveLoggedAccountDetails(ctx).Execute() //nolint
+	if err != nil {
+		// The account is a oneOf that the SDK fails to decode when the API
+		// adds fields, so fall back to the fields needed here.
+		if response != nil && response.StatusCode < http.StatusMultipleChoices {
+			var body struct {
+				Data accountInfo `json:"data"`
+			}
+			if decodeErr := json.NewDecoder(response.Body).Decode(&body); decodeErr == nil {
+				return &body.Data, response, nil
+			}
+		}
+		return nil, response, err
+	}
+
+	switch account := accountResponse.Data; {
+	case account.Brand != nil:
+		return &accountInfo{ID: account.Brand.Id, Name: account.Brand.Name, Type: account.Brand.Type}, response, nil
+	case account.Reseller != nil:
+		return &accountInfo{ID: account.Reseller.Id, Name: account.Reseller.Name, Type: account.Reseller.Type}, response, nil
+	case account.Organization != nil:
+		return &accountInfo{ID: account.Organization.Id, Name: account.Organization.Name, Type: account.Organization.Type}, response, nil
+	case account.Workspace != nil:
+		return &accountInfo{ID: account.Workspace.Id, Name: account.Workspace.Name, Type: account.Workspace.Type}, response, nil
+	default:
+		return nil, response, errors.New("the API returned an account of an unknown type")
+	}
+}
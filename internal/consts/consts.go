@@ -5,3 +5,15 @@ const (
 	APITokenSchemaKey = "api_token"
 	UserAgentDefault  = "terraform/%s terraform-provider-azion/%s"
 )
+
+// Azion environments selectable through the provider's environment attribute.
+const (
+	EnvironmentProduction = "production"
+	EnvironmentStage      = "stage"
+)
+
+// Base URLs of the v4 API in each environment.
+const (
+	APIURLProduction = "https://api.azion.com/v4"
+	APIURLStage      = "https://stage-api.azion.com/v4"
+)
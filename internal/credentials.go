@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// defaultProfile is the profile read from the credentials file when none is
+// configured.
+const defaultProfile = "default"
+
+// credentialsFile is the TOML settings file shared with the Azion CLI. The
+// CLI writes its token at the top level, which is treated as the default
+// profile, and named profiles live in [profiles.<name>] tables:
+//
+//	token = "<default token>"
+//
+//	[profiles.staging]
+//	token = "<staging token>"
+type credentialsFile struct {
+	Token    string                        `toml:"token"`
+	Profiles map[string]credentialsProfile `toml:"profiles"`
+}
+
+type credentialsProfile struct {
+	Token string `toml:"token"`
+}
+
+// defaultCredentialsFile returns the path of the Azion CLI settings file, or
+// an empty string when the home directory cannot be determined.
+func defaultCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".azion", "settings.toml")
+}
+
+// configureToken resolves the API token from, in order: api_token, the
+// profile attribute, AZION_API_TOKEN, AZION_PROFILE and finally the default
+// profile of the credentials file, when that file exists.
+func (p *azionProvider) configureToken(config AzionProviderModel, diags *diag.Diagnostics) string {
+	if !config.APIToken.IsNull() {
+		return config.APIToken.ValueString()
+	}
+
+	file, fileExplicit := os.Getenv("AZION_CREDENTIALS_FILE"), true
+	if !config.CredentialsFile.IsNull() {
+		file = config.CredentialsFile.ValueString()
+	}
+	if file == "" {
+		file, fileExplicit = defaultCredentialsFile(), false
+	}
+
+	if !config.Profile.IsNull() {
+		return profileToken(file, config.Profile.ValueString(), path.Root("profile"), diags)
+	}
+	if token := os.Getenv("AZION_API_TOKEN"); token != "" {
+		return token
+	}
+	if profile := os.Getenv("AZION_PROFILE"); profile != "" {
+		return profileToken(file, profile, path.Empty(), diags)
+	}
+
+	if file == "" {
+		return ""
+	}
+	if _, err := os.Stat(file); errors.Is(err, fs.ErrNotExist) && !fileExplicit {
+		return ""
+	}
+	return profileToken(file, defaultProfile, path.Empty(), diags)
+}
+
+// profileToken reads the token of profile from the credentials file. Errors
+// are reported on attrPath, or as general errors when it is empty.
+func profileToken(file, profile string, attrPath path.Path, diags *diag.Diagnostics) string {
+	addError := func(summary, detail string) {
+		if attrPath.Equal(path.Empty()) {
+			diags.AddError(summary, detail)
+			return
+		}
+		diags.AddAttributeError(attrPath, summary, detail)
+	}
+
+	if file == "" {
+		addError("Unable to locate credentials file", "The home directory could not be determined. Set credentials_file or AZION_CREDENTIALS_FILE.")
+		return ""
+	}
+
+	var credentials credentialsFile
+	if _, err := toml.DecodeFile(file, &credentials); err != nil {
+		addError("Unable to read credentials file", fmt.Sprintf("Could not read %s: %s", file, err))
+		return ""
+	}
+
+	token := credentials.Profiles[profile].Token
+	if token == "" && profile == defaultProfile {
+		token = credentials.Token
+	}
+	if token == "" {
+		names := make([]string, 0, len(credentials.Profiles)+1)
+		if credentials.Token != "" && credentials.Profiles[defaultProfile].Token == "" {
+			names = append(names, defaultProfile)
+		}
+		for name, p := range credentials.Profiles {
+			if p.Token != "" {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		available := "none"
+		if len(names) > 0 {
+			available = strings.Join(names, ", ")
+		}
+		addError(
+			"Profile not found",
+			fmt.Sprintf("No token was found for profile %q in %s. Profiles with a token: %s.", profile, file, available),
+		)
+		return ""
+	}
+	return token
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &AccountDataSource{}
+	_ datasource.DataSourceWithConfigure = &AccountDataSource{}
+)
+
+func dataSourceAzionAccount() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+type AccountDataSource struct {
+	client *apiClient
+}
+
+type AccountDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	ClientType types.String `tfsdk:"client_type"`
+}
+
+func (d *AccountDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*apiClient)
+}
+
+func (d *AccountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *AccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the Azion account that the provider's API token belongs to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the account.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the account.",
+				Computed:    true,
+			},
+			"client_type": schema.StringAttribute{
+				Description: "Client type of the account: `Brand`, `Reseller`, `Organization` or `Workspace`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AccountDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// The account is resolved when the provider validates its token, unless
+	// that was skipped.
+	account := d.client.account
+	if account == nil {
+		loggedAccount, response, err := d.client.loggedAccount(ctx)
+		if err != nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
+			return
+		}
+		account = loggedAccount
+	}
+
+	accountState := AccountDataSourceModel{
+		ID:         types.StringValue(strconv.FormatInt(account.ID, 10)),
+		Name:       types.StringValue(account.Name),
+		ClientType: types.StringValue(account.Type),
+	}
+
+	diags := resp.State.Set(ctx, &accountState)
+	resp.Diagnostics.Append(diags...)
+}
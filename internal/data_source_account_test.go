@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aziontech/terraform-provider-azion/internal/mockapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAccountDataSource(t *testing.T) {
+	server := testAccMockAPI(t)
+	server.SetAccount(4321, "Example", "Workspace")
+	server.InjectFault(mockapi.Fault{Method: http.MethodGet, Path: "/account/account", Status: http.StatusTooManyRequests, Times: 1})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfig + `data "azion_account" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azion_account.test", "id", "4321"),
+					resource.TestCheckResourceAttr("data.azion_account.test", "name", "Example"),
+					resource.TestCheckResourceAttr("data.azion_account.test", "client_type", "Workspace"),
+				),
+			},
+		},
+	})
+}
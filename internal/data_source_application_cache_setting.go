@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -294,7 +296,7 @@ func (d *CacheSettingDataSource) Read(ctx context.Context, req datasource.ReadRe
 // to work around SDK validation issues with the data wrapper.
 func retrieveCacheSettingRawDS(ctx context.Context, client *apiClient, applicationId, cacheSettingId int64) (*azionapi.CacheSetting, error) {
 	// Build the request URL
-	url := fmt.Sprintf("https://api.azion.com/v4/edge_applications/%d/cache_settings/%d", applicationId, cacheSettingId)
+	url := fmt.Sprintf("%s/edge_applications/%d/cache_settings/%d", client.apiConfig.Servers[0].URL, applicationId, cacheSettingId)
 
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -331,12 +333,8 @@ func retrieveCacheSettingRawDS(ctx context.Context, client *apiClient, applicati
 		return nil, fmt.Errorf("404")
 	}
 	if httpResp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error: %s - %s", httpResp.Status, string(bodyBytes))
-	}
-
-	// Handle rate limiting
-	if httpResp.StatusCode == 429 {
-		return nil, fmt.Errorf("rate limited")
+		httpResp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		return nil, utils.DecodeAPIError(httpResp)
 	}
 
 	// Parse the response - the API returns {"data": {...}} wrapper
@@ -2,14 +2,15 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,6 +32,10 @@ type CacheSettingsDataSourceModel struct {
 	Counter       types.Int64         `tfsdk:"counter"`
 	Page          types.Int64         `tfsdk:"page"`
 	PageSize      types.Int64         `tfsdk:"page_size"`
+	FetchAll      types.Bool          `tfsdk:"fetch_all"`
+	Filter        types.Object        `tfsdk:"filter"`
+	Search        types.String        `tfsdk:"search"`
+	Ordering      types.String        `tfsdk:"ordering"`
 	TotalPages    types.Int64         `tfsdk:"total_pages"`
 	Links         *LinksModel         `tfsdk:"links"`
 	Results       []CacheSettingModel `tfsdk:"results"`
@@ -71,6 +76,15 @@ func (d *CacheSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Description: "The Page Size number of Cache Settings.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of Cache Settings instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("cache settings"),
+			"ordering": listOrderingAttribute("cache settings"),
 			"total_pages": schema.Int64Attribute{
 				Description: "The total number of pages.",
 				Computed:    true,
@@ -215,6 +229,9 @@ func (d *CacheSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.CacheSetting]("cache settings"),
+		},
 	}
 }
 
@@ -236,6 +253,17 @@ func (d *CacheSettingsDataSource) Read(ctx context.Context, req datasource.ReadR
 	diags = req.Config.GetAttribute(ctx, path.Root("page_size"), &pageSize)
 	resp.Diagnostics.Append(diags...)
 
+	query := readListQuery[azionapi.CacheSetting](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diags = req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diags...)
+
+	allPages := listAllPages[azionapi.ApiListCacheSettingsRequest](query, fetchAll, page, pageSize)
+
 	// Set defaults
 	if page.IsNull() || page.IsUnknown() {
 		page = types.Int64Value(1)
@@ -244,34 +272,24 @@ func (d *CacheSettingsDataSource) Read(ctx context.Context, req datasource.ReadR
 		pageSize = types.Int64Value(10)
 	}
 
-	listResponse, response, err := d.client.api.ApplicationsCacheSettingsAPI.ListCacheSettings(ctx, applicationID.ValueInt64()).Page(page.ValueInt64()).PageSize(pageSize.ValueInt64()).Execute()
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedCacheSettingList, *http.Response, error) {
+		return applyListQuery(d.client.api.ApplicationsCacheSettingsAPI.ListCacheSettings(ctx, applicationID.ValueInt64()), query).Page(page).PageSize(pageSize).Execute()
+	}
+	var listResponse *azionapi.PaginatedCacheSettingList
+	var response *http.Response
+	var err error
+	if allPages {
+		listResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		listResponse, response, err = fetch(page.ValueInt64(), pageSize.ValueInt64())
+	}
 	if err != nil {
-		if response.StatusCode == 429 {
-			listResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedCacheSettingList, *http.Response, error) {
-				return d.client.api.ApplicationsCacheSettingsAPI.ListCacheSettings(ctx, applicationID.ValueInt64()).Page(page.ValueInt64()).PageSize(pageSize.ValueInt64()).Execute()
-			}, 5)
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
 
-			if response != nil {
-				defer response.Body.Close()
-			}
+	filterListResults(listResponse, query, allPages)
 
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(err.Error(), bodyString)
-			if response != nil {
-				response.Body.Close()
-			}
-			return
-		}
-	}
 	if response != nil {
 		defer response.Body.Close()
 	}
@@ -281,6 +299,10 @@ func (d *CacheSettingsDataSource) Read(ctx context.Context, req datasource.ReadR
 		ApplicationID: applicationID,
 		Page:          page,
 		PageSize:      pageSize,
+		FetchAll:      fetchAll,
+		Filter:        query.Filter,
+		Search:        query.Search,
+		Ordering:      query.Ordering,
 	}
 
 	if listResponse.HasCount() {
@@ -317,6 +339,8 @@ func (d *CacheSettingsDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	state.ID = types.Int64Value(0)
+	clearPagination(allPages, &state.Page, &state.PageSize)
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
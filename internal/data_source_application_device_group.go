@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -116,27 +115,13 @@ func (d *ApplicationDeviceGroupDataSource) Read(ctx context.Context, req datasou
 	deviceGroupResponse, response, err := d.client.api.ApplicationsDeviceGroupsAPI.
 		RetrieveDeviceGroup(ctx, applicationID.ValueInt64(), deviceGroupIDInt).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			deviceGroupResponse, response, err = utils.RetryOn429(func() (*azionapi.DeviceGroupResponse, *http.Response, error) {
-				return d.client.api.ApplicationsDeviceGroupsAPI.RetrieveDeviceGroup(ctx, applicationID.ValueInt64(), deviceGroupIDInt).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintApplicationDeviceGroup(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintApplicationDeviceGroup(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
 	if response != nil {
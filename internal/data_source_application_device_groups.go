@@ -31,6 +31,10 @@ type ApplicationDeviceGroupsDataSourceModel struct {
 	ApplicationID types.Int64                      `tfsdk:"application_id"`
 	Counter       types.Int64                      `tfsdk:"counter"`
 	TotalPages    types.Int64                      `tfsdk:"total_pages"`
+	FetchAll      types.Bool                       `tfsdk:"fetch_all"`
+	Filter        types.Object                     `tfsdk:"filter"`
+	Search        types.String                     `tfsdk:"search"`
+	Ordering      types.String                     `tfsdk:"ordering"`
 	Results       []ApplicationDeviceGroupsResults `tfsdk:"results"`
 	ID            types.String                     `tfsdk:"id"`
 }
@@ -72,6 +76,12 @@ func (d *ApplicationDeviceGroupsDataSource) Schema(_ context.Context, _ datasour
 				Description: "The total number of pages.",
 				Computed:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of device groups instead of the first one.",
+				Optional:    true,
+			},
+			"search":   listSearchAttribute("device groups"),
+			"ordering": listOrderingAttribute("device groups"),
 			"results": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -96,6 +106,9 @@ func (d *ApplicationDeviceGroupsDataSource) Schema(_ context.Context, _ datasour
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.DeviceGroup]("device groups"),
+		},
 	}
 }
 
@@ -108,38 +121,53 @@ func (d *ApplicationDeviceGroupsDataSource) Read(ctx context.Context, req dataso
 		return
 	}
 
-	deviceGroupsResponse, response, err := d.client.api.ApplicationsDeviceGroupsAPI.
-		ListDeviceGroups(ctx, applicationID.ValueInt64()).Execute() //nolint
+	query := readListQuery[azionapi.DeviceGroup](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diags = req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListDeviceGroupsRequest](query, fetchAll)
+
+	listRequest := applyListQuery(d.client.api.ApplicationsDeviceGroupsAPI.ListDeviceGroups(ctx, applicationID.ValueInt64()), query)
+	var deviceGroupsResponse *azionapi.PaginatedDeviceGroupList
+	var response *http.Response
+	var err error
+	if allPages {
+		deviceGroupsResponse, response, err = utils.FetchAll(ctx, func(page, pageSize int64) (*azionapi.PaginatedDeviceGroupList, *http.Response, error) {
+			return listRequest.Page(page).PageSize(pageSize).Execute() //nolint
+		})
+	} else {
+		deviceGroupsResponse, response, err = listRequest.Execute() //nolint
+	}
 	if err != nil {
-		if response.StatusCode == 429 {
-			deviceGroupsResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedDeviceGroupList, *http.Response, error) {
-				return d.client.api.ApplicationsDeviceGroupsAPI.ListDeviceGroups(ctx, applicationID.ValueInt64()).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintApplicationDeviceGroups(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintApplicationDeviceGroups(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
+	filterListResults(deviceGroupsResponse, query, allPages)
+
 	if response != nil {
 		defer response.Body.Close()
 	}
 
 	deviceGroupsState := ApplicationDeviceGroupsDataSourceModel{
 		ApplicationID: applicationID,
+		FetchAll:      fetchAll,
+		Filter:        query.Filter,
+		Search:        query.Search,
+		Ordering:      query.Ordering,
 	}
 
 	if deviceGroupsResponse.Count != nil {
@@ -2,13 +2,10 @@ package provider
 
 import (
 	"context"
-	"io"
-	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
-	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -111,37 +108,8 @@ func (d *ApplicationFunctionInstanceDataSource) Read(ctx context.Context, req da
 
 	functionInstanceResponse, response, err := d.client.api.ApplicationsFunctionAPI.RetrieveApplicationFunctionInstance(ctx, applicationID.ValueInt64(), functionInstanceID.ValueInt64()).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			functionInstanceResponse, response, err = utils.RetryOn429(func() (*azionapi.FunctionInstanceResponse, *http.Response, error) {
-				return d.client.api.ApplicationsFunctionAPI.RetrieveApplicationFunctionInstance(ctx, applicationID.ValueInt64(), functionInstanceID.ValueInt64()).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"error reading response from api",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
 	jsonArgsStr, err := utils.ConvertInterfaceToString(functionInstanceResponse.Data.GetArgs())
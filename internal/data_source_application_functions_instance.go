@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"time"
 
@@ -10,8 +9,10 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,6 +34,10 @@ type FunctionInstancesDataSourceModel struct {
 	ApplicationID types.Int64                `tfsdk:"application_id"`
 	Page          types.Int64                `tfsdk:"page"`
 	PageSize      types.Int64                `tfsdk:"page_size"`
+	FetchAll      types.Bool                 `tfsdk:"fetch_all"`
+	Filter        types.Object               `tfsdk:"filter"`
+	Search        types.String               `tfsdk:"search"`
+	Ordering      types.String               `tfsdk:"ordering"`
 	TotalCount    types.Int64                `tfsdk:"total_count"`
 	Results       []FunctionInstanceResponse `tfsdk:"results"`
 }
@@ -78,6 +83,15 @@ func (d *ApplicationFunctionInstancesDataSource) Schema(_ context.Context, _ dat
 				Description: "Number of items per page.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of function instances instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("function instances"),
+			"ordering": listOrderingAttribute("function instances"),
 			"total_count": schema.Int64Attribute{
 				Description: "The total number of function instances.",
 				Computed:    true,
@@ -122,6 +136,9 @@ func (d *ApplicationFunctionInstancesDataSource) Schema(_ context.Context, _ dat
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.FunctionInstance]("function instances"),
+		},
 	}
 }
 
@@ -142,6 +159,20 @@ func (d *ApplicationFunctionInstancesDataSource) Read(ctx context.Context, req d
 		return
 	}
 
+	query := readListQuery[azionapi.FunctionInstance](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListApplicationFunctionInstancesRequest](query, fetchAll, page, pageSize)
+
 	diagsApplicationID := req.Config.GetAttribute(ctx, path.Root("application_id"), &applicationID)
 	resp.Diagnostics.Append(diagsApplicationID...)
 	if resp.Diagnostics.HasError() {
@@ -155,45 +186,32 @@ func (d *ApplicationFunctionInstancesDataSource) Read(ctx context.Context, req d
 		pageSize = types.Int64Value(10)
 	}
 
-	functionInstancesResponse, response, err := d.client.api.ApplicationsFunctionAPI.ListApplicationFunctionInstances(ctx, applicationID.ValueInt64()).Page(page.ValueInt64()).PageSize(pageSize.ValueInt64()).Execute() //nolint
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedFunctionInstanceList, *http.Response, error) {
+		return applyListQuery(d.client.api.ApplicationsFunctionAPI.ListApplicationFunctionInstances(ctx, applicationID.ValueInt64()), query).Page(page).PageSize(pageSize).Execute() //nolint
+	}
+	var functionInstancesResponse *azionapi.PaginatedFunctionInstanceList
+	var response *http.Response
+	var err error
+	if allPages {
+		functionInstancesResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		functionInstancesResponse, response, err = fetch(page.ValueInt64(), pageSize.ValueInt64())
+	}
 	if err != nil {
-		if response.StatusCode == 429 {
-			functionInstancesResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedFunctionInstanceList, *http.Response, error) {
-				return d.client.api.ApplicationsFunctionAPI.ListApplicationFunctionInstances(ctx, applicationID.ValueInt64()).Page(page.ValueInt64()).PageSize(pageSize.ValueInt64()).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"error reading response from api",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
+	filterListResults(functionInstancesResponse, query, allPages)
+
 	state := FunctionInstancesDataSourceModel{
 		ApplicationID: applicationID,
 		Page:          page,
 		PageSize:      pageSize,
+		FetchAll:      fetchAll,
+		Filter:        query.Filter,
+		Search:        query.Search,
+		Ordering:      query.Ordering,
 		TotalCount:    types.Int64Value(functionInstancesResponse.GetCount()),
 	}
 
@@ -218,6 +236,8 @@ func (d *ApplicationFunctionInstancesDataSource) Read(ctx context.Context, req d
 	}
 
 	state.ID = types.Int64Value(0)
+	clearPagination(allPages, &state.Page, &state.PageSize)
+
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
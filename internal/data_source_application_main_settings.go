@@ -2,12 +2,9 @@ package provider
 
 import (
 	"context"
-	"io"
-	"net/http"
 	"strconv"
 	"time"
 
-	sdk "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -204,37 +201,8 @@ func (e *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	applicationsResponse, response, err := e.client.api.ApplicationsAPI.RetrieveApplication(ctx, applicationId).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			applicationsResponse, response, err = utils.RetryOn429(func() (*sdk.ApplicationResponse, *http.Response, error) {
-				return e.client.api.ApplicationsAPI.RetrieveApplication(ctx, applicationId).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
 	mods := applicationsResponse.Data.GetModules()
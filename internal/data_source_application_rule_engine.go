@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
@@ -272,30 +271,8 @@ func (r *RuleEngineDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			switch phaseStr {
-			case "request":
-				result, response, err = r.readRequestRuleWithRetry(ctx, applicationID.ValueInt64(), ruleID.ValueInt64(), phaseStr)
-			case "response":
-				result, response, err = r.readResponseRuleWithRetry(ctx, applicationID.ValueInt64(), ruleID.ValueInt64(), phaseStr)
-			}
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else if response != nil {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(err.Error(), bodyString)
-			response.Body.Close()
+		if response != nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		} else {
 			resp.Diagnostics.AddError(err.Error(), "API request failed")
@@ -326,18 +303,6 @@ func (r *RuleEngineDataSource) readRequestRule(ctx context.Context, applicationI
 	return transformResponsePhaseRule(ruleResponse.Data, phase), response, nil
 }
 
-func (r *RuleEngineDataSource) readRequestRuleWithRetry(ctx context.Context, applicationID, ruleID int64, phase string) (RuleEngineResultModel, *http.Response, error) {
-	ruleResponse, response, err := utils.RetryOn429(func() (*azionapi.ResponsePhaseRuleResponse, *http.Response, error) {
-		return r.client.api.ApplicationsRequestRulesAPI.
-			RetrieveApplicationRequestRule(ctx, applicationID, ruleID).
-			Execute()
-	}, 5)
-	if err != nil {
-		return RuleEngineResultModel{}, response, err
-	}
-	return transformResponsePhaseRule(ruleResponse.Data, phase), response, nil
-}
-
 func (r *RuleEngineDataSource) readResponseRule(ctx context.Context, applicationID, ruleID int64, phase string) (RuleEngineResultModel, *http.Response, error) {
 	ruleResponse, response, err := r.client.api.ApplicationsResponseRulesAPI.
 		RetrieveApplicationResponseRule(ctx, applicationID, ruleID).
@@ -348,18 +313,6 @@ func (r *RuleEngineDataSource) readResponseRule(ctx context.Context, application
 	return transformResponsePhaseRule(ruleResponse.Data, phase), response, nil
 }
 
-func (r *RuleEngineDataSource) readResponseRuleWithRetry(ctx context.Context, applicationID, ruleID int64, phase string) (RuleEngineResultModel, *http.Response, error) {
-	ruleResponse, response, err := utils.RetryOn429(func() (*azionapi.ResponsePhaseRuleResponse, *http.Response, error) {
-		return r.client.api.ApplicationsResponseRulesAPI.
-			RetrieveApplicationResponseRule(ctx, applicationID, ruleID).
-			Execute()
-	}, 5)
-	if err != nil {
-		return RuleEngineResultModel{}, response, err
-	}
-	return transformResponsePhaseRule(ruleResponse.Data, phase), response, nil
-}
-
 func transformResponsePhaseRule(rule azionapi.ResponsePhaseRule, phase string) RuleEngineResultModel {
 	result := RuleEngineResultModel{
 		ID:    types.Int64Value(rule.GetId()),
@@ -3,15 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -35,6 +36,10 @@ type RulesEngineDataSourceModel struct {
 	TotalPages    types.Int64              `tfsdk:"total_pages"`
 	Page          types.Int64              `tfsdk:"page"`
 	PageSize      types.Int64              `tfsdk:"page_size"`
+	FetchAll      types.Bool               `tfsdk:"fetch_all"`
+	Filter        types.Object             `tfsdk:"filter"`
+	Search        types.String             `tfsdk:"search"`
+	Ordering      types.String             `tfsdk:"ordering"`
 	Links         *LinksModel              `tfsdk:"links"`
 	Results       []RulesEngineResultModel `tfsdk:"results"`
 }
@@ -91,6 +96,15 @@ func (r *RulesEngineDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Description: "The page size.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of rules instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("rules"),
+			"ordering": listOrderingAttribute("rules"),
 			"total_pages": schema.Int64Attribute{
 				Description: "The total number of pages.",
 				Computed:    true,
@@ -230,6 +244,9 @@ func (r *RulesEngineDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.RequestPhaseRule]("rules"),
+		},
 	}
 }
 
@@ -264,6 +281,23 @@ func (r *RulesEngineDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	query := readListQuery[azionapi.RequestPhaseRule](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListApplicationRequestRulesRequest](query, fetchAll, page, pageSize)
+	if phase.ValueString() == "response" {
+		allPages = listAllPages[azionapi.ApiListApplicationResponseRulesRequest](query, fetchAll, page, pageSize)
+	}
+
 	// Set defaults
 	if page.IsNull() || page.IsUnknown() {
 		page = types.Int64Value(1)
@@ -279,9 +313,9 @@ func (r *RulesEngineDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	switch phaseStr {
 	case "request":
-		result, response, err = r.listRequestRules(ctx, applicationID.ValueInt64(), page.ValueInt64(), pageSize.ValueInt64(), phaseStr)
+		result, response, err = r.listRequestRules(ctx, applicationID.ValueInt64(), page.ValueInt64(), pageSize.ValueInt64(), phaseStr, allPages, query)
 	case "response":
-		result, response, err = r.listResponseRules(ctx, applicationID.ValueInt64(), page.ValueInt64(), pageSize.ValueInt64(), phaseStr)
+		result, response, err = r.listResponseRules(ctx, applicationID.ValueInt64(), page.ValueInt64(), pageSize.ValueInt64(), phaseStr, allPages, query)
 	default:
 		resp.Diagnostics.AddError(
 			"Invalid phase value",
@@ -291,30 +325,8 @@ func (r *RulesEngineDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			switch phaseStr {
-			case "request":
-				result, response, err = r.listRequestRulesWithRetry(ctx, applicationID.ValueInt64(), page.ValueInt64(), pageSize.ValueInt64(), phaseStr)
-			case "response":
-				result, response, err = r.listResponseRulesWithRetry(ctx, applicationID.ValueInt64(), page.ValueInt64(), pageSize.ValueInt64(), phaseStr)
-			}
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else if response != nil {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(err.Error(), bodyString)
-			response.Body.Close()
+		if response != nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		} else {
 			resp.Diagnostics.AddError(err.Error(), "API request failed")
@@ -326,66 +338,60 @@ func (r *RulesEngineDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	result.ApplicationID = applicationID
+	result.FetchAll = fetchAll
+	result.Filter = query.Filter
+	result.Search = query.Search
+	result.Ordering = query.Ordering
 	result.ID = types.StringValue("Get All Application Rules Engine")
 
+	clearPagination(allPages, &result.Page, &result.PageSize)
+
 	diags := resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
 
-func (r *RulesEngineDataSource) listRequestRules(ctx context.Context, applicationID, page, pageSize int64, phase string) (RulesEngineDataSourceModel, *http.Response, error) {
-	listReq := r.client.api.ApplicationsRequestRulesAPI.
-		ListApplicationRequestRules(ctx, applicationID).
-		Page(page).
-		PageSize(pageSize)
-
-	listResponse, response, err := listReq.Execute()
-	if err != nil {
-		return RulesEngineDataSourceModel{}, response, err
-	}
-
-	return transformPaginatedRequestPhaseRuleList(listResponse, phase), response, nil
-}
-
-func (r *RulesEngineDataSource) listRequestRulesWithRetry(ctx context.Context, applicationID, page, pageSize int64, phase string) (RulesEngineDataSourceModel, *http.Response, error) {
-	listResponse, response, err := utils.RetryOn429(func() (*azionapi.PaginatedRequestPhaseRuleList, *http.Response, error) {
-		return r.client.api.ApplicationsRequestRulesAPI.
-			ListApplicationRequestRules(ctx, applicationID).
+func (r *RulesEngineDataSource) listRequestRules(ctx context.Context, applicationID, page, pageSize int64, phase string, fetchAll bool, query listQuery) (RulesEngineDataSourceModel, *http.Response, error) {
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedRequestPhaseRuleList, *http.Response, error) {
+		return applyListQuery(r.client.api.ApplicationsRequestRulesAPI.ListApplicationRequestRules(ctx, applicationID), query).
 			Page(page).
 			PageSize(pageSize).
 			Execute()
-	}, 5)
-	if err != nil {
-		return RulesEngineDataSourceModel{}, response, err
 	}
-
-	return transformPaginatedRequestPhaseRuleList(listResponse, phase), response, nil
-}
-
-func (r *RulesEngineDataSource) listResponseRules(ctx context.Context, applicationID, page, pageSize int64, phase string) (RulesEngineDataSourceModel, *http.Response, error) {
-	listReq := r.client.api.ApplicationsResponseRulesAPI.
-		ListApplicationResponseRules(ctx, applicationID).
-		Page(page).
-		PageSize(pageSize)
-
-	listResponse, response, err := listReq.Execute()
+	var listResponse *azionapi.PaginatedRequestPhaseRuleList
+	var response *http.Response
+	var err error
+	if fetchAll {
+		listResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		listResponse, response, err = fetch(page, pageSize)
+	}
 	if err != nil {
 		return RulesEngineDataSourceModel{}, response, err
 	}
+	filterListResults(listResponse, query, fetchAll)
 
-	return transformPaginatedResponsePhaseRuleList(listResponse, phase), response, nil
+	return transformPaginatedRequestPhaseRuleList(listResponse, phase), response, nil
 }
 
-func (r *RulesEngineDataSource) listResponseRulesWithRetry(ctx context.Context, applicationID, page, pageSize int64, phase string) (RulesEngineDataSourceModel, *http.Response, error) {
-	listResponse, response, err := utils.RetryOn429(func() (*azionapi.PaginatedResponsePhaseRuleList, *http.Response, error) {
-		return r.client.api.ApplicationsResponseRulesAPI.
-			ListApplicationResponseRules(ctx, applicationID).
+func (r *RulesEngineDataSource) listResponseRules(ctx context.Context, applicationID, page, pageSize int64, phase string, fetchAll bool, query listQuery) (RulesEngineDataSourceModel, *http.Response, error) {
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedResponsePhaseRuleList, *http.Response, error) {
+		return applyListQuery(r.client.api.ApplicationsResponseRulesAPI.ListApplicationResponseRules(ctx, applicationID), query).
 			Page(page).
 			PageSize(pageSize).
 			Execute()
-	}, 5)
+	}
+	var listResponse *azionapi.PaginatedResponsePhaseRuleList
+	var response *http.Response
+	var err error
+	if fetchAll {
+		listResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		listResponse, response, err = fetch(page, pageSize)
+	}
 	if err != nil {
 		return RulesEngineDataSourceModel{}, response, err
 	}
+	filterListResults(listResponse, query, fetchAll)
 
 	return transformPaginatedResponsePhaseRuleList(listResponse, phase), response, nil
 }
@@ -2,15 +2,16 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"time"
 
-	sdk "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,6 +32,10 @@ type ApplicationsDataSourceModel struct {
 	TotalCount types.Int64       `tfsdk:"total_count"`
 	Page       types.Int64       `tfsdk:"page"`
 	PageSize   types.Int64       `tfsdk:"page_size"`
+	FetchAll   types.Bool        `tfsdk:"fetch_all"`
+	Filter     types.Object      `tfsdk:"filter"`
+	Search     types.String      `tfsdk:"search"`
+	Ordering   types.String      `tfsdk:"ordering"`
 	Results    []ApplicationData `tfsdk:"results"`
 	ID         types.String      `tfsdk:"id"`
 }
@@ -81,6 +86,15 @@ func (e *ApplicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: "The Page Size number of edge applications.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of edge applications instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("applications"),
+			"ordering": listOrderingAttribute("applications"),
 			"results": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -171,6 +185,9 @@ func (e *ApplicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.Application]("applications"),
+		},
 	}
 }
 
@@ -189,6 +206,20 @@ func (e *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	query := readListQuery[azionapi.Application](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListApplicationsRequest](query, fetchAll, Page, PageSize)
+
 	if Page.ValueInt64() == 0 {
 		Page = types.Int64Value(1)
 	}
@@ -196,45 +227,32 @@ func (e *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		PageSize = types.Int64Value(10)
 	}
 
-	appResponse, response, err := e.client.api.ApplicationsAPI.ListApplications(ctx).Page(Page.ValueInt64()).PageSize(PageSize.ValueInt64()).Execute() //nolint
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedApplicationList, *http.Response, error) {
+		return applyListQuery(e.client.api.ApplicationsAPI.ListApplications(ctx), query).Page(page).PageSize(pageSize).Execute() //nolint
+	}
+	var appResponse *azionapi.PaginatedApplicationList
+	var response *http.Response
+	var err error
+	if allPages {
+		appResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		appResponse, response, err = fetch(Page.ValueInt64(), PageSize.ValueInt64())
+	}
 	if err != nil {
-		if response.StatusCode == 429 {
-			appResponse, response, err = utils.RetryOn429(func() (*sdk.PaginatedApplicationList, *http.Response, error) {
-				return e.client.api.ApplicationsAPI.ListApplications(ctx).Page(Page.ValueInt64()).PageSize(PageSize.ValueInt64()).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
+	filterListResults(appResponse, query, allPages)
+
 	appState := ApplicationsDataSourceModel{
 		Page:       Page,
 		PageSize:   PageSize,
-		TotalCount: types.Int64Value(*appResponse.Count),
+		FetchAll:   fetchAll,
+		Filter:     query.Filter,
+		Search:     query.Search,
+		Ordering:   query.Ordering,
+		TotalCount: types.Int64Value(appResponse.GetCount()),
 	}
 
 	for _, resultApplication := range appResponse.GetResults() {
@@ -274,6 +292,8 @@ func (e *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		})
 	}
 	appState.ID = types.StringValue("Get All Application")
+	clearPagination(allPages, &appState.Page, &appState.PageSize)
+
 	diags := resp.State.Set(ctx, &appState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
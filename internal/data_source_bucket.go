@@ -3,10 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"time"
 
-	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -104,27 +102,13 @@ func (d *BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		RetrieveBucket(ctx, bucketName.ValueString()).
 		Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			bucketResponse, response, err = utils.RetryOn429(func() (*azionapi.BucketCreateResponse, *http.Response, error) {
-				return d.client.api.StorageBucketsAPI.RetrieveBucket(ctx, bucketName.ValueString()).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintBucket(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintBucket(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
 	if response != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,6 +32,10 @@ type BucketsDataSourceModel struct {
 	TotalPages types.Int64           `tfsdk:"total_pages"`
 	Page       types.Int64           `tfsdk:"page"`
 	PageSize   types.Int64           `tfsdk:"page_size"`
+	FetchAll   types.Bool            `tfsdk:"fetch_all"`
+	Filter     types.Object          `tfsdk:"filter"`
+	Search     types.String          `tfsdk:"search"`
+	Ordering   types.String          `tfsdk:"ordering"`
 	Results    []BucketsResultsModel `tfsdk:"results"`
 	ID         types.String          `tfsdk:"id"`
 }
@@ -77,6 +82,12 @@ func (d *BucketsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "The number of items per page.",
 				Computed:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of buckets instead of the first one.",
+				Optional:    true,
+			},
+			"search":   listSearchAttribute("buckets"),
+			"ordering": listOrderingAttribute("buckets"),
 			"results": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -105,43 +116,60 @@ func (d *BucketsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.Bucket]("buckets"),
+		},
 	}
 }
 
 func (d *BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	bucketsResponse, response, err := d.client.api.StorageBucketsAPI.
-		ListBuckets(ctx).
-		Execute() //nolint
-	if err != nil {
-		if response.StatusCode == 429 {
-			bucketsResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedBucketList, *http.Response, error) {
-				return d.client.api.StorageBucketsAPI.ListBuckets(ctx).Execute() //nolint
-			}, 5) // Maximum 5 retries
+	query := readListQuery[azionapi.Bucket](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if response != nil {
-				defer response.Body.Close()
-			}
+	var fetchAll types.Bool
+	diags := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintBuckets(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+	allPages := listAllPages[azionapi.ApiListBucketsRequest](query, fetchAll)
+
+	listRequest := applyListQuery(d.client.api.StorageBucketsAPI.ListBuckets(ctx), query)
+	var bucketsResponse *azionapi.PaginatedBucketList
+	var response *http.Response
+	var err error
+	if allPages {
+		bucketsResponse, response, err = utils.FetchAll(ctx, func(page, pageSize int64) (*azionapi.PaginatedBucketList, *http.Response, error) {
+			return listRequest.Page(page).PageSize(pageSize).Execute() //nolint
+		})
+	} else {
+		bucketsResponse, response, err = listRequest.Execute() //nolint
+	}
+	if err != nil {
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintBuckets(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
+	filterListResults(bucketsResponse, query, allPages)
+
 	if response != nil {
 		defer response.Body.Close()
 	}
 
 	bucketsState := BucketsDataSourceModel{
-		ID: types.StringValue("buckets"),
+		ID:       types.StringValue("buckets"),
+		FetchAll: fetchAll,
+		Filter:   query.Filter,
+		Search:   query.Search,
+		Ordering: query.Ordering,
 	}
 
 	if bucketsResponse.Count != nil {
@@ -174,7 +202,9 @@ func (d *BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		bucketsState.Results = results
 	}
 
-	diags := resp.State.Set(ctx, &bucketsState)
+	clearPagination(allPages, &bucketsState.Page, &bucketsState.PageSize)
+
+	diags = resp.State.Set(ctx, &bucketsState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
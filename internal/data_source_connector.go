@@ -10,6 +10,7 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,8 +18,9 @@ import (
 )
 
 var (
-	_ datasource.DataSource              = &ConnectorDataSource{}
-	_ datasource.DataSourceWithConfigure = &ConnectorDataSource{}
+	_ datasource.DataSource                     = &ConnectorDataSource{}
+	_ datasource.DataSourceWithConfigure        = &ConnectorDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ConnectorDataSource{}
 )
 
 func dataSourceAzionConnector() datasource.DataSource {
@@ -32,6 +34,7 @@ type ConnectorDataSource struct {
 type ConnectorDataSourceModel struct {
 	Data ConnectorResults `tfsdk:"data"`
 	ID   types.String     `tfsdk:"id"`
+	Name types.String     `tfsdk:"name"`
 }
 
 type ConnectorResults struct {
@@ -65,8 +68,14 @@ func (d *ConnectorDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Numeric identifier of the data source.",
-				Required:    true,
+				Description: "Numeric identifier of the connector. Conflicts with `name`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the connector, looked up when `id` is not set. It must match exactly one connector.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"data": schema.SingleNestedAttribute{
 				Computed: true,
@@ -129,6 +138,12 @@ func (d *ConnectorDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 	}
 }
 
+func (d *ConnectorDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
 func (d *ConnectorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var getConnectorId types.String
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &getConnectorId)
@@ -137,39 +152,44 @@ func (d *ConnectorDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	connectorID, err := strconv.ParseInt(getConnectorId.ValueString(), 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Value Conversion error ",
-			"Could not convert ID",
-		)
+	var name types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("name"), &name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var connectorID int64
+	var err error
+	if !name.IsNull() {
+		var ok bool
+		connectorID, ok = lookupIDByName(ctx, "connector", name.ValueString(), func(page, pageSize int64) (*azionapi.PaginatedConnectorList, *http.Response, error) {
+			return d.client.api.ConnectorsAPI.ListConnectors(ctx).Name(name.ValueString()).Page(page).PageSize(pageSize).Execute() //nolint
+		}, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+	} else {
+		connectorID, err = strconv.ParseInt(getConnectorId.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Value Conversion error ",
+				"Could not convert ID",
+			)
+			return
+		}
+	}
+
 	connectorResponse, response, err := d.client.api.ConnectorsAPI.
 		RetrieveConnector(ctx, connectorID).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			connectorResponse, response, err = utils.RetryOn429(func() (*azionapi.ConnectorResponse, *http.Response, error) {
-				return d.client.api.ConnectorsAPI.RetrieveConnector(ctx, connectorID).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintConnector(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintConnector(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
 	if response != nil {
@@ -185,7 +205,8 @@ func (d *ConnectorDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	connectorState.ID = types.StringValue("Get By Id Connector")
+	connectorState.ID = types.StringValue(strconv.FormatInt(connectorID, 10))
+	connectorState.Name = connectorState.Data.Name
 	diags = resp.State.Set(ctx, &connectorState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
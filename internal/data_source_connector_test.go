@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccConnectorDataSource(t *testing.T) {
+	testAccMockAPI(t)
+	const name = "data.azion_connector.by_name"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorStorageConfig("assets", "my-bucket") + `
+data "azion_connector" "by_name" {
+  name = azion_connector.test.connector.name
+}
+
+data "azion_connector" "by_id" {
+  id = azion_connector.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "azion_connector.test", "id"),
+					resource.TestCheckResourceAttr(name, "name", "assets"),
+					resource.TestCheckResourceAttr(name, "data.type", "storage"),
+					resource.TestCheckResourceAttrPair("data.azion_connector.by_id", "id", "azion_connector.test", "id"),
+					resource.TestCheckResourceAttr("data.azion_connector.by_id", "name", "assets"),
+				),
+			},
+			{
+				Config: testAccConnectorStorageConfig("assets", "my-bucket") + `
+resource "azion_connector" "twin" {
+  connector = {
+    name   = "assets"
+    type   = "storage"
+    active = true
+    storage_attributes = {
+      bucket = "other-bucket"
+      prefix = "assets/"
+    }
+  }
+}
+
+data "azion_connector" "by_name" {
+  name       = "assets"
+  depends_on = [azion_connector.twin]
+}
+`,
+				ExpectError: regexp.MustCompile(`Multiple connectors found`),
+			},
+		},
+	})
+}
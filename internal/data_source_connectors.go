@@ -11,6 +11,7 @@ import (
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -28,9 +29,13 @@ type ConnectorsDataSource struct {
 }
 
 type ConnectorsDataSourceModel struct {
-	Counter types.Int64         `tfsdk:"counter"`
-	Results []ConnectorsResults `tfsdk:"results"`
-	ID      types.String        `tfsdk:"id"`
+	Counter  types.Int64         `tfsdk:"counter"`
+	FetchAll types.Bool          `tfsdk:"fetch_all"`
+	Filter   types.Object        `tfsdk:"filter"`
+	Search   types.String        `tfsdk:"search"`
+	Ordering types.String        `tfsdk:"ordering"`
+	Results  []ConnectorsResults `tfsdk:"results"`
+	ID       types.String        `tfsdk:"id"`
 }
 
 type ConnectorsResults struct {
@@ -71,6 +76,12 @@ func (d *ConnectorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Description: "The total count of connectors.",
 				Computed:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of connectors instead of the first one.",
+				Optional:    true,
+			},
+			"search":   listSearchAttribute("connectors"),
+			"ordering": listOrderingAttribute("connectors"),
 			"results": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -131,40 +142,60 @@ func (d *ConnectorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.Connector]("connectors"),
+		},
 	}
 }
 
 func (d *ConnectorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	connectorsResponse, response, err := d.client.api.ConnectorsAPI.ListConnectors(ctx).Execute() //nolint
-	if err != nil {
-		if response.StatusCode == 429 {
-			connectorsResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedConnectorList, *http.Response, error) {
-				return d.client.api.ConnectorsAPI.ListConnectors(ctx).Execute() //nolint
-			}, 5) // Maximum 5 retries
+	query := readListQuery[azionapi.Connector](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diags := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if response != nil {
-				defer response.Body.Close()
-			}
+	allPages := listAllPages[azionapi.ApiListConnectorsRequest](query, fetchAll)
 
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintConnectors(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+	listRequest := applyListQuery(d.client.api.ConnectorsAPI.ListConnectors(ctx), query)
+	var connectorsResponse *azionapi.PaginatedConnectorList
+	var response *http.Response
+	var err error
+	if allPages {
+		connectorsResponse, response, err = utils.FetchAll(ctx, func(page, pageSize int64) (*azionapi.PaginatedConnectorList, *http.Response, error) {
+			return listRequest.Page(page).PageSize(pageSize).Execute() //nolint
+		})
+	} else {
+		connectorsResponse, response, err = listRequest.Execute() //nolint
+	}
+	if err != nil {
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintConnectors(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
+	filterListResults(connectorsResponse, query, allPages)
+
 	if response != nil {
 		defer response.Body.Close()
 	}
 
-	connectorsState := ConnectorsDataSourceModel{}
+	connectorsState := ConnectorsDataSourceModel{
+		FetchAll: fetchAll,
+		Filter:   query.Filter,
+		Search:   query.Search,
+		Ordering: query.Ordering,
+	}
 
 	if connectorsResponse.Count != nil {
 		connectorsState.Counter = types.Int64Value(*connectorsResponse.Count)
@@ -183,7 +214,7 @@ func (d *ConnectorsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	connectorsState.ID = types.StringValue("Get All Connectors")
-	diags := resp.State.Set(ctx, &connectorsState)
+	diags = resp.State.Set(ctx, &connectorsState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -2,8 +2,6 @@ package provider
 
 import (
 	"context"
-	"io"
-	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
@@ -137,37 +135,8 @@ func (c *CrlDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 
 	crlResponse, response, err := c.client.api.DigitalCertificatesCertificateRevocationListsAPI.RetrieveCertificateRevocationList(ctx, getCrlID.ValueInt64()).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			crlResponse, response, err = utils.RetryOn429(func() (*azionapi.CertificateRevocationListResponse, *http.Response, error) {
-				return c.client.api.DigitalCertificatesCertificateRevocationListsAPI.RetrieveCertificateRevocationList(ctx, getCrlID.ValueInt64()).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"time"
 
@@ -10,6 +9,7 @@ import (
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -32,6 +32,10 @@ type CrlsDataSourceModel struct {
 	TotalPages    types.Int64       `tfsdk:"total_pages"`
 	Page          types.Int64       `tfsdk:"page"`
 	PageSize      types.Int64       `tfsdk:"page_size"`
+	FetchAll      types.Bool        `tfsdk:"fetch_all"`
+	Filter        types.Object      `tfsdk:"filter"`
+	Search        types.String      `tfsdk:"search"`
+	Ordering      types.String      `tfsdk:"ordering"`
 	Links         *CrlLinksModel    `tfsdk:"links"`
 	SchemaVersion types.Int64       `tfsdk:"schema_version"`
 	Results       []CrlsResultModel `tfsdk:"results"`
@@ -94,6 +98,12 @@ func (d *CrlsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: "The number of items per page.",
 				Computed:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of certificate revocation lists instead of the first one.",
+				Optional:    true,
+			},
+			"search":   listSearchAttribute("certificate revocation lists"),
+			"ordering": listOrderingAttribute("certificate revocation lists"),
 			"links": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -157,52 +167,58 @@ func (d *CrlsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.CertificateRevocationList]("certificate revocation lists"),
+		},
 	}
 }
 
 func (d *CrlsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	crlsResponse, response, err := d.client.api.DigitalCertificatesCertificateRevocationListsAPI.ListCertificateRevocationLists(ctx).Execute()
-	if err != nil {
-		if response.StatusCode == 429 {
-			crlsResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedCertificateRevocationList, *http.Response, error) {
-				return d.client.api.DigitalCertificatesCertificateRevocationListsAPI.ListCertificateRevocationLists(ctx).Execute()
-			}, 5)
+	query := readListQuery[azionapi.CertificateRevocationList](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diags := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if response != nil {
-				defer response.Body.Close()
-			}
+	allPages := listAllPages[azionapi.ApiListCertificateRevocationListsRequest](query, fetchAll)
 
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+	listRequest := applyListQuery(d.client.api.DigitalCertificatesCertificateRevocationListsAPI.ListCertificateRevocationLists(ctx), query)
+	var crlsResponse *azionapi.PaginatedCertificateRevocationList
+	var response *http.Response
+	var err error
+	if allPages {
+		crlsResponse, response, err = utils.FetchAll(ctx, func(page, pageSize int64) (*azionapi.PaginatedCertificateRevocationList, *http.Response, error) {
+			return listRequest.Page(page).PageSize(pageSize).Execute() //nolint
+		})
+	} else {
+		crlsResponse, response, err = listRequest.Execute() //nolint
+	}
+	if err != nil {
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
 		}
 	}
 
+	filterListResults(crlsResponse, query, allPages)
+
 	state := populateCrlsListResults(crlsResponse)
 	state.ID = types.StringValue("Get All Certificate Revocation Lists")
-	diags := resp.State.Set(ctx, &state)
+	state.FetchAll = fetchAll
+	state.Filter = query.Filter
+	state.Search = query.Search
+	state.Ordering = query.Ordering
+	clearPagination(allPages, &state.Page, &state.PageSize)
+
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
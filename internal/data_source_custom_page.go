@@ -3,11 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strconv"
 	"time"
 
-	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -210,27 +208,13 @@ func (d *CustomPageDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	customPageResponse, response, err := d.client.api.CustomPagesAPI.
 		RetrieveCustomPage(ctx, customPageID).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			customPageResponse, response, err = utils.RetryOn429(func() (*azionapi.CustomPageResponse, *http.Response, error) {
-				return d.client.api.CustomPagesAPI.RetrieveCustomPage(ctx, customPageID).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintCustomPage(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintCustomPage(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
 	customPageState := CustomPageDataSourceModel{
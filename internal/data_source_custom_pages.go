@@ -10,6 +10,7 @@ import (
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,9 +28,13 @@ type CustomPagesDataSource struct {
 }
 
 type CustomPagesDataSourceModel struct {
-	Counter types.Int64          `tfsdk:"counter"`
-	Results []CustomPagesResults `tfsdk:"results"`
-	ID      types.String         `tfsdk:"id"`
+	Counter  types.Int64          `tfsdk:"counter"`
+	FetchAll types.Bool           `tfsdk:"fetch_all"`
+	Filter   types.Object         `tfsdk:"filter"`
+	Search   types.String         `tfsdk:"search"`
+	Ordering types.String         `tfsdk:"ordering"`
+	Results  []CustomPagesResults `tfsdk:"results"`
+	ID       types.String         `tfsdk:"id"`
 }
 
 type CustomPagesResults struct {
@@ -90,6 +95,12 @@ func (d *CustomPagesDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Description: "The total count of custom pages.",
 				Computed:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of custom pages instead of the first one.",
+				Optional:    true,
+			},
+			"search":   listSearchAttribute("custom pages"),
+			"ordering": listOrderingAttribute("custom pages"),
 			"results": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -192,37 +203,56 @@ func (d *CustomPagesDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.CustomPage]("custom pages"),
+		},
 	}
 }
 
 func (d *CustomPagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	customPagesResponse, response, err := d.client.api.CustomPagesAPI.ListCustomPages(ctx).Execute() //nolint
-	if err != nil {
-		if response.StatusCode == 429 {
-			customPagesResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedCustomPageList, *http.Response, error) {
-				return d.client.api.CustomPagesAPI.ListCustomPages(ctx).Execute() //nolint
-			}, 5) // Maximum 5 retries
+	query := readListQuery[azionapi.CustomPage](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if response != nil {
-				defer response.Body.Close()
-			}
+	var fetchAll types.Bool
+	diags := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintCustomPages(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+	allPages := listAllPages[azionapi.ApiListCustomPagesRequest](query, fetchAll)
+
+	listRequest := applyListQuery(d.client.api.CustomPagesAPI.ListCustomPages(ctx), query)
+	var customPagesResponse *azionapi.PaginatedCustomPageList
+	var response *http.Response
+	var err error
+	if allPages {
+		customPagesResponse, response, err = utils.FetchAll(ctx, func(page, pageSize int64) (*azionapi.PaginatedCustomPageList, *http.Response, error) {
+			return listRequest.Page(page).PageSize(pageSize).Execute() //nolint
+		})
+	} else {
+		customPagesResponse, response, err = listRequest.Execute() //nolint
+	}
+	if err != nil {
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintCustomPages(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
+	filterListResults(customPagesResponse, query, allPages)
+
 	customPagesState := CustomPagesDataSourceModel{
-		Counter: types.Int64Value(*customPagesResponse.Count),
+		Counter:  types.Int64Value(*customPagesResponse.Count),
+		FetchAll: fetchAll,
+		Filter:   query.Filter,
+		Search:   query.Search,
+		Ordering: query.Ordering,
 	}
 
 	for _, resultCustomPage := range customPagesResponse.GetResults() {
@@ -278,7 +308,7 @@ func (d *CustomPagesDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	customPagesState.ID = types.StringValue("Get All Custom Pages")
-	diags := resp.State.Set(ctx, &customPagesState)
+	diags = resp.State.Set(ctx, &customPagesState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
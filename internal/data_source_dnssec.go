@@ -4,9 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"net/http"
 
-	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -176,38 +174,8 @@ func (d *dnssecDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		// Check if the error is due to JSON unmarshaling (unknown field) but HTTP request was successful
 		if response != nil && response.StatusCode >= 200 && response.StatusCode < 300 {
 			// HTTP request was successful, proceed to parse response manually
-		} else if response != nil && response.StatusCode == 429 {
-			_, response, err = utils.RetryOn429(func() (*azionapi.DNSSECResponse, *http.Response, error) {
-				return d.client.api.DNSDNSSECAPI.RetrieveDnssec(ctx, zoneId).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				// Check again if it's a successful response after retry
-				if response == nil || response.StatusCode < 200 || response.StatusCode >= 300 {
-					resp.Diagnostics.AddError(
-						err.Error(),
-						"API request failed after too many retries",
-					)
-					return
-				}
-			}
 		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
 	}
@@ -2,15 +2,16 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"time"
 
-	sdk "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -28,6 +29,10 @@ type FirewallFunctionsInstanceDataSourceModel struct {
 	Counter    types.Int64                       `tfsdk:"counter"`
 	Page       types.Int64                       `tfsdk:"page"`
 	PageSize   types.Int64                       `tfsdk:"page_size"`
+	FetchAll   types.Bool                        `tfsdk:"fetch_all"`
+	Filter     types.Object                      `tfsdk:"filter"`
+	Search     types.String                      `tfsdk:"search"`
+	Ordering   types.String                      `tfsdk:"ordering"`
 	TotalPages types.Int64                       `tfsdk:"total_pages"`
 	Results    []FirewallFunctionInstanceResults `tfsdk:"results"`
 }
@@ -77,6 +82,15 @@ func (f *FirewallFunctionsInstanceDataSource) Schema(_ context.Context, _ dataso
 				Description: "The Page Size number of firewall function instances.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of firewall function instances instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("firewall function instances"),
+			"ordering": listOrderingAttribute("firewall function instances"),
 			"total_pages": schema.Int64Attribute{
 				Description: "The total number of pages.",
 				Computed:    true,
@@ -121,6 +135,9 @@ func (f *FirewallFunctionsInstanceDataSource) Schema(_ context.Context, _ dataso
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.FirewallFunctionInstance]("firewall function instances"),
+		},
 	}
 }
 
@@ -147,6 +164,20 @@ func (f *FirewallFunctionsInstanceDataSource) Read(ctx context.Context, req data
 		return
 	}
 
+	query := readListQuery[azionapi.FirewallFunctionInstance](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListFirewallFunctionRequest](query, fetchAll, page, pageSize)
+
 	if page.ValueInt64() == 0 {
 		page = types.Int64Value(1)
 	}
@@ -154,47 +185,27 @@ func (f *FirewallFunctionsInstanceDataSource) Read(ctx context.Context, req data
 		pageSize = types.Int64Value(10)
 	}
 
-	firewallFunctionInstancesResponse, response, err := f.client.api.FirewallsFunctionAPI.
-		ListFirewallFunction(ctx, firewallID.ValueInt64()).
-		Page(page.ValueInt64()).
-		PageSize(pageSize.ValueInt64()).
-		Execute() //nolint
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedFirewallFunctionInstanceList, *http.Response, error) {
+		return applyListQuery(f.client.api.FirewallsFunctionAPI.ListFirewallFunction(ctx, firewallID.ValueInt64()), query).
+			Page(page).
+			PageSize(pageSize).
+			Execute() //nolint
+	}
+	var firewallFunctionInstancesResponse *azionapi.PaginatedFirewallFunctionInstanceList
+	var response *http.Response
+	var err error
+	if allPages {
+		firewallFunctionInstancesResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		firewallFunctionInstancesResponse, response, err = fetch(page.ValueInt64(), pageSize.ValueInt64())
+	}
 	if err != nil {
-		if response.StatusCode == 429 {
-			firewallFunctionInstancesResponse, response, err = utils.RetryOn429(func() (*sdk.PaginatedFirewallFunctionInstanceList, *http.Response, error) {
-				return f.client.api.FirewallsFunctionAPI.
-					ListFirewallFunction(ctx, firewallID.ValueInt64()).Page(page.ValueInt64()).
-					PageSize(pageSize.ValueInt64()).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
+	filterListResults(firewallFunctionInstancesResponse, query, allPages)
+
 	var functionInstancesResults []FirewallFunctionInstanceResults
 	for _, result := range firewallFunctionInstancesResponse.GetResults() {
 		jsonArgsStr, err := utils.ConvertInterfaceToString(result.GetArgs())
@@ -224,10 +235,16 @@ func (f *FirewallFunctionsInstanceDataSource) Read(ctx context.Context, req data
 		Counter:    types.Int64Value(firewallFunctionInstancesResponse.GetCount()),
 		Page:       page,
 		PageSize:   pageSize,
+		FetchAll:   fetchAll,
+		Filter:     query.Filter,
+		Search:     query.Search,
+		Ordering:   query.Ordering,
 		TotalPages: types.Int64Value(firewallFunctionInstancesResponse.GetTotalPages()),
 		Results:    functionInstancesResults,
 	}
 
+	clearPagination(allPages, &state.Page, &state.PageSize)
+
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
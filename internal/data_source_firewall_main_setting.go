@@ -2,18 +2,24 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"time"
 
-	sdk "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var (
+	_ datasource.DataSource                     = &FirewallDataSource{}
+	_ datasource.DataSourceWithConfigure        = &FirewallDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &FirewallDataSource{}
+)
+
 func dataSourceAzionFirewall() datasource.DataSource {
 	return &FirewallDataSource{}
 }
@@ -25,6 +31,7 @@ type FirewallDataSource struct {
 type FirewallDataSourceModel struct {
 	ID         types.String    `tfsdk:"id"`
 	FirewallID types.Int64     `tfsdk:"firewall_id"`
+	Name       types.String    `tfsdk:"name"`
 	Data       FirewallResults `tfsdk:"data"`
 }
 
@@ -86,8 +93,14 @@ func (f *FirewallDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:    true,
 			},
 			"firewall_id": schema.Int64Attribute{
-				Description: "The firewall identifier.",
-				Required:    true,
+				Description: "The firewall identifier. Conflicts with `name`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the firewall, looked up when `firewall_id` is not set. It must match exactly one firewall.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"data": schema.SingleNestedAttribute{
 				Computed: true,
@@ -192,6 +205,12 @@ func (f *FirewallDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	}
 }
 
+func (f *FirewallDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("firewall_id"), path.MatchRoot("name")),
+	}
+}
+
 func (f *FirewallDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var getFirewallID types.Int64
 	diagsFirewallID := req.Config.GetAttribute(ctx, path.Root("firewall_id"), &getFirewallID)
@@ -200,39 +219,27 @@ func (f *FirewallDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	firewallResponse, response, err := f.client.api.FirewallsAPI.RetrieveFirewall(ctx, getFirewallID.ValueInt64()).Execute() //nolint
-	if err != nil {
-		if response.StatusCode == 429 {
-			firewallResponse, response, err = utils.RetryOn429(func() (*sdk.FirewallResponse, *http.Response, error) {
-				return f.client.api.FirewallsAPI.RetrieveFirewall(ctx, getFirewallID.ValueInt64()).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
+	var name types.String
+	diagsName := req.Config.GetAttribute(ctx, path.Root("name"), &name)
+	resp.Diagnostics.Append(diagsName...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+	if !name.IsNull() {
+		id, ok := lookupIDByName(ctx, "firewall", name.ValueString(), func(page, pageSize int64) (*azionapi.PaginatedFirewallList, *http.Response, error) {
+			return f.client.api.FirewallsAPI.ListFirewalls(ctx).Name(name.ValueString()).Page(page).PageSize(pageSize).Execute() //nolint
+		}, &resp.Diagnostics)
+		if !ok {
 			return
 		}
+		getFirewallID = types.Int64Value(id)
+	}
+
+	firewallResponse, response, err := f.client.api.FirewallsAPI.RetrieveFirewall(ctx, getFirewallID.ValueInt64()).Execute() //nolint
+	if err != nil {
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
 	mods := firewallResponse.Data.GetModules()
@@ -274,6 +281,7 @@ func (f *FirewallDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	firewallState := FirewallDataSourceModel{
 		FirewallID: getFirewallID,
+		Name:       firewallResults.Name,
 		Data:       firewallResults,
 	}
 
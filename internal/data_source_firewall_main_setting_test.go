@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFirewallDataSource(t *testing.T) {
+	testAccMockAPI(t)
+	const name = "data.azion_firewall_main_setting.by_name"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallConfig("edge-firewall", true) + `
+data "azion_firewall_main_setting" "by_name" {
+  name = azion_firewall_main_setting.test.data.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "firewall_id", "azion_firewall_main_setting.test", "data.id"),
+					resource.TestCheckResourceAttr(name, "name", "edge-firewall"),
+					resource.TestCheckResourceAttr(name, "data.name", "edge-firewall"),
+				),
+			},
+			{
+				Config: testAccFirewallConfig("edge-firewall", true) + `
+data "azion_firewall_main_setting" "by_name" {
+  name       = "edge"
+  depends_on = [azion_firewall_main_setting.test]
+}
+`,
+				ExpectError: regexp.MustCompile(`No firewall has\s+the\s+name\s+"edge"\.\s+Candidates\s+with\s+a\s+similar\s+name:\s+"edge-firewall"`),
+			},
+		},
+	})
+}
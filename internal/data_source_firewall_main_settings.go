@@ -2,15 +2,16 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"time"
 
-	sdk "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,6 +26,10 @@ type FirewallsDataSource struct {
 type FirewallsDataSourceModel struct {
 	Page     types.Int64        `tfsdk:"page"`
 	PageSize types.Int64        `tfsdk:"page_size"`
+	FetchAll types.Bool         `tfsdk:"fetch_all"`
+	Filter   types.Object       `tfsdk:"filter"`
+	Search   types.String       `tfsdk:"search"`
+	Ordering types.String       `tfsdk:"ordering"`
 	Counter  types.Int64        `tfsdk:"counter"`
 	Results  []FirewallsResults `tfsdk:"results"`
 }
@@ -67,6 +72,15 @@ func (f *FirewallsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Description: "The page size number of firewalls.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of firewalls instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("firewalls"),
+			"ordering": listOrderingAttribute("firewalls"),
 			"counter": schema.Int64Attribute{
 				Description: "The total number of firewalls.",
 				Computed:    true,
@@ -173,6 +187,9 @@ func (f *FirewallsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.Firewall]("firewalls"),
+		},
 	}
 }
 
@@ -192,6 +209,20 @@ func (f *FirewallsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	query := readListQuery[azionapi.Firewall](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListFirewallsRequest](query, fetchAll, Page, PageSize)
+
 	if Page.ValueInt64() == 0 {
 		Page = types.Int64Value(1)
 	}
@@ -199,41 +230,24 @@ func (f *FirewallsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		PageSize = types.Int64Value(10)
 	}
 
-	firewallsResponse, response, err := f.client.api.FirewallsAPI.ListFirewalls(ctx).Page(Page.ValueInt64()).PageSize(PageSize.ValueInt64()).Execute() //nolint
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedFirewallList, *http.Response, error) {
+		return applyListQuery(f.client.api.FirewallsAPI.ListFirewalls(ctx), query).Page(page).PageSize(pageSize).Execute() //nolint
+	}
+	var firewallsResponse *azionapi.PaginatedFirewallList
+	var response *http.Response
+	var err error
+	if allPages {
+		firewallsResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		firewallsResponse, response, err = fetch(Page.ValueInt64(), PageSize.ValueInt64())
+	}
 	if err != nil {
-		if response.StatusCode == 429 {
-			firewallsResponse, response, err = utils.RetryOn429(func() (*sdk.PaginatedFirewallList, *http.Response, error) {
-				return f.client.api.FirewallsAPI.ListFirewalls(ctx).Page(Page.ValueInt64()).PageSize(PageSize.ValueInt64()).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
+	filterListResults(firewallsResponse, query, allPages)
+
 	var firewallsResults []FirewallsResults
 	for _, results := range firewallsResponse.Results {
 		mods := results.GetModules()
@@ -278,10 +292,16 @@ func (f *FirewallsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	firewallsState := FirewallsDataSourceModel{
 		Page:     Page,
 		PageSize: PageSize,
+		FetchAll: fetchAll,
+		Filter:   query.Filter,
+		Search:   query.Search,
+		Ordering: query.Ordering,
 		Counter:  types.Int64Value(firewallsResponse.GetCount()),
 		Results:  firewallsResults,
 	}
 
+	clearPagination(allPages, &firewallsState.Page, &firewallsState.PageSize)
+
 	diags := resp.State.Set(ctx, &firewallsState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
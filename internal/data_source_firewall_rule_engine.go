@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
@@ -271,25 +270,8 @@ func (r *FirewallRuleEngineDataSource) Read(ctx context.Context, req datasource.
 
 	result, response, err := r.readRuleDataSource(ctx, firewallID.ValueInt64(), ruleID.ValueInt64())
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			result, response, err = r.readRuleDataSourceWithRetry(ctx, firewallID.ValueInt64(), ruleID.ValueInt64())
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else if response != nil {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(err.Error(), bodyString)
-			response.Body.Close()
+		if response != nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		} else {
 			resp.Diagnostics.AddError(err.Error(), "API request failed")
@@ -320,18 +302,6 @@ func (r *FirewallRuleEngineDataSource) readRuleDataSource(ctx context.Context, f
 	return transformFirewallRuleResponseToDataModel(ruleResponse.Data), response, nil
 }
 
-func (r *FirewallRuleEngineDataSource) readRuleDataSourceWithRetry(ctx context.Context, firewallID, ruleID int64) (*FirewallRuleEngineResultDataModel, *http.Response, error) {
-	ruleResponse, response, err := utils.RetryOn429(func() (*azionapi.FirewallRuleResponse, *http.Response, error) {
-		return r.client.api.FirewallsRulesEngineAPI.
-			RetrieveFirewallRule(ctx, firewallID, ruleID).
-			Execute()
-	}, 5)
-	if err != nil {
-		return nil, response, err
-	}
-	return transformFirewallRuleResponseToDataModel(ruleResponse.Data), response, nil
-}
-
 func transformFirewallRuleResponseToDataModel(rule azionapi.FirewallRule) *FirewallRuleEngineResultDataModel {
 	result := &FirewallRuleEngineResultDataModel{
 		ID:    types.Int64Value(rule.GetId()),
@@ -3,15 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -35,6 +36,10 @@ type FirewallRulesEngineDataSourceModel struct {
 	TotalPages types.Int64                      `tfsdk:"total_pages"`
 	Page       types.Int64                      `tfsdk:"page"`
 	PageSize   types.Int64                      `tfsdk:"page_size"`
+	FetchAll   types.Bool                       `tfsdk:"fetch_all"`
+	Filter     types.Object                     `tfsdk:"filter"`
+	Search     types.String                     `tfsdk:"search"`
+	Ordering   types.String                     `tfsdk:"ordering"`
 	Links      *LinksModel                      `tfsdk:"links"`
 	Results    []FirewallRulesEngineResultModel `tfsdk:"results"`
 }
@@ -86,6 +91,15 @@ func (r *FirewallRulesEngineDataSource) Schema(_ context.Context, _ datasource.S
 				Description: "The page size.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of rules instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("rules"),
+			"ordering": listOrderingAttribute("rules"),
 			"total_pages": schema.Int64Attribute{
 				Description: "The total number of pages.",
 				Computed:    true,
@@ -243,6 +257,9 @@ func (r *FirewallRulesEngineDataSource) Schema(_ context.Context, _ datasource.S
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.FirewallRule]("rules"),
+		},
 	}
 }
 
@@ -269,6 +286,20 @@ func (r *FirewallRulesEngineDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
+	query := readListQuery[azionapi.FirewallRule](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListFirewallRulesRequest](query, fetchAll, page, pageSize)
+
 	// Set defaults
 	if page.IsNull() || page.IsUnknown() {
 		page = types.Int64Value(1)
@@ -277,27 +308,10 @@ func (r *FirewallRulesEngineDataSource) Read(ctx context.Context, req datasource
 		pageSize = types.Int64Value(10)
 	}
 
-	result, response, err := r.listRules(ctx, firewallID.ValueInt64(), page.ValueInt64(), pageSize.ValueInt64())
+	result, response, err := r.listRules(ctx, firewallID.ValueInt64(), page.ValueInt64(), pageSize.ValueInt64(), allPages, query)
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			result, response, err = r.listRulesWithRetry(ctx, firewallID.ValueInt64(), page.ValueInt64(), pageSize.ValueInt64())
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else if response != nil {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(err.Error(), bodyString)
-			response.Body.Close()
+		if response != nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		} else {
 			resp.Diagnostics.AddError(err.Error(), "API request failed")
@@ -309,37 +323,37 @@ func (r *FirewallRulesEngineDataSource) Read(ctx context.Context, req datasource
 	}
 
 	result.FirewallID = firewallID
+	result.FetchAll = fetchAll
+	result.Filter = query.Filter
+	result.Search = query.Search
+	result.Ordering = query.Ordering
 	result.ID = types.StringValue("Get All Firewall Rules Engine")
 
+	clearPagination(allPages, &result.Page, &result.PageSize)
+
 	diags := resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
 }
 
-func (r *FirewallRulesEngineDataSource) listRules(ctx context.Context, firewallID, page, pageSize int64) (FirewallRulesEngineDataSourceModel, *http.Response, error) {
-	listReq := r.client.api.FirewallsRulesEngineAPI.
-		ListFirewallRules(ctx, firewallID).
-		Page(page).
-		PageSize(pageSize)
-
-	listResponse, response, err := listReq.Execute()
-	if err != nil {
-		return FirewallRulesEngineDataSourceModel{}, response, err
-	}
-
-	return transformPaginatedFirewallRuleList(listResponse), response, nil
-}
-
-func (r *FirewallRulesEngineDataSource) listRulesWithRetry(ctx context.Context, firewallID, page, pageSize int64) (FirewallRulesEngineDataSourceModel, *http.Response, error) {
-	listResponse, response, err := utils.RetryOn429(func() (*azionapi.PaginatedFirewallRuleList, *http.Response, error) {
-		return r.client.api.FirewallsRulesEngineAPI.
-			ListFirewallRules(ctx, firewallID).
+func (r *FirewallRulesEngineDataSource) listRules(ctx context.Context, firewallID, page, pageSize int64, fetchAll bool, query listQuery) (FirewallRulesEngineDataSourceModel, *http.Response, error) {
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedFirewallRuleList, *http.Response, error) {
+		return applyListQuery(r.client.api.FirewallsRulesEngineAPI.ListFirewallRules(ctx, firewallID), query).
 			Page(page).
 			PageSize(pageSize).
 			Execute()
-	}, 5)
+	}
+	var listResponse *azionapi.PaginatedFirewallRuleList
+	var response *http.Response
+	var err error
+	if fetchAll {
+		listResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		listResponse, response, err = fetch(page, pageSize)
+	}
 	if err != nil {
 		return FirewallRulesEngineDataSourceModel{}, response, err
 	}
+	filterListResults(listResponse, query, fetchAll)
 
 	return transformPaginatedFirewallRuleList(listResponse), response, nil
 }
@@ -9,6 +9,7 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,8 +17,9 @@ import (
 )
 
 var (
-	_ datasource.DataSource              = &functionDataSource{}
-	_ datasource.DataSourceWithConfigure = &functionDataSource{}
+	_ datasource.DataSource                     = &functionDataSource{}
+	_ datasource.DataSourceWithConfigure        = &functionDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &functionDataSource{}
 )
 
 func dataSourceAzionFunction() datasource.DataSource {
@@ -31,6 +33,7 @@ type functionDataSource struct {
 type functionDataSourceModel struct {
 	Data functionResults `tfsdk:"data"`
 	ID   types.String    `tfsdk:"id"`
+	Name types.String    `tfsdk:"name"`
 }
 
 type GetFunctionResponseLinks struct {
@@ -73,8 +76,14 @@ func (d *functionDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Numeric identifier of the data source.",
-				Required:    true,
+				Description: "Numeric identifier of the function. Conflicts with `name`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the function, looked up when `id` is not set. It must match exactly one function.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"data": schema.SingleNestedAttribute{
 				Computed: true,
@@ -153,6 +162,12 @@ func (d *functionDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	}
 }
 
+func (d *functionDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
 func (d *functionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var getFunctionId types.String
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &getFunctionId)
@@ -161,39 +176,44 @@ func (d *functionDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	functionID, err := strconv.ParseInt(getFunctionId.ValueString(), 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Value Conversion error ",
-			"Could not convert ID",
-		)
+	var name types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("name"), &name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var functionID int64
+	var err error
+	if !name.IsNull() {
+		var ok bool
+		functionID, ok = lookupIDByName(ctx, "function", name.ValueString(), func(page, pageSize int64) (*azionapi.PaginatedEdgeFunctionList, *http.Response, error) {
+			return d.client.api.FunctionsAPI.ListFunctions(ctx).Name(name.ValueString()).Page(page).PageSize(pageSize).Execute() //nolint
+		}, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+	} else {
+		functionID, err = strconv.ParseInt(getFunctionId.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Value Conversion error ",
+				"Could not convert ID",
+			)
+			return
+		}
+	}
+
 	functionsResponse, response, err := d.client.api.FunctionsAPI.
 		RetrieveFunction(ctx, functionID).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			functionsResponse, response, err = utils.RetryOn429(func() (*azionapi.FunctionResponse, *http.Response, error) {
-				return d.client.api.FunctionsAPI.RetrieveFunction(ctx, functionID).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrint(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrint(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
 	defaultArgsStr := ""
@@ -234,7 +254,8 @@ func (d *functionDataSource) Read(ctx context.Context, req datasource.ReadReques
 		FunctionState.Data.Runtime = types.StringValue(*functionsResponse.Data.Runtime)
 	}
 
-	FunctionState.ID = types.StringValue("Get By Id Function")
+	FunctionState.ID = types.StringValue(strconv.FormatInt(functionID, 10))
+	FunctionState.Name = FunctionState.Data.Name
 	diags = resp.State.Set(ctx, &FunctionState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
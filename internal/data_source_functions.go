@@ -9,6 +9,7 @@ import (
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -26,9 +27,13 @@ type functionsDataSource struct {
 }
 
 type functionsDataSourceModel struct {
-	Counter types.Int64        `tfsdk:"counter"`
-	Results []functionsResults `tfsdk:"results"`
-	ID      types.String       `tfsdk:"id"`
+	Counter  types.Int64        `tfsdk:"counter"`
+	FetchAll types.Bool         `tfsdk:"fetch_all"`
+	Filter   types.Object       `tfsdk:"filter"`
+	Search   types.String       `tfsdk:"search"`
+	Ordering types.String       `tfsdk:"ordering"`
+	Results  []functionsResults `tfsdk:"results"`
+	ID       types.String       `tfsdk:"id"`
 }
 
 type GetFunctionsResponseLinks struct {
@@ -77,6 +82,12 @@ func (d *functionsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Description: "The total count of functions.",
 				Computed:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of functions instead of the first one.",
+				Optional:    true,
+			},
+			"search":   listSearchAttribute("functions"),
+			"ordering": listOrderingAttribute("functions"),
 			"results": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -149,6 +160,9 @@ func (d *functionsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.Function]("functions"),
+		},
 	}
 }
 
@@ -161,45 +175,57 @@ func (d *functionsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	functionsResponse, response, err := d.client.api.FunctionsAPI.ListFunctions(ctx).Execute() //nolint
-	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			functionsResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedEdgeFunctionList, *http.Response, error) {
-				return d.client.api.FunctionsAPI.ListFunctions(ctx).Execute() //nolint
-			}, 5) // Maximum 5 retries
+	query := readListQuery[azionapi.Function](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
+	var fetchAll types.Bool
+	diags := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
+	allPages := listAllPages[azionapi.ApiListFunctionsRequest](query, fetchAll)
+
+	listRequest := applyListQuery(d.client.api.FunctionsAPI.ListFunctions(ctx), query)
+	var functionsResponse *azionapi.PaginatedEdgeFunctionList
+	var response *http.Response
+	var err error
+	if allPages {
+		functionsResponse, response, err = utils.FetchAll(ctx, func(page, pageSize int64) (*azionapi.PaginatedEdgeFunctionList, *http.Response, error) {
+			return listRequest.Page(page).PageSize(pageSize).Execute() //nolint
+		})
+	} else {
+		functionsResponse, response, err = listRequest.Execute() //nolint
+	}
+	if err != nil {
+		if response != nil {
+			usrMsg, errMsg := errPrintFunctions(response.StatusCode, err)
+			resp.Diagnostics.AddError(usrMsg, errMsg)
 		} else {
-			if response != nil {
-				usrMsg, errMsg := errPrintFunctions(response.StatusCode, err)
-				resp.Diagnostics.AddError(usrMsg, errMsg)
-			} else {
-				resp.Diagnostics.AddError(
-					"API request failed",
-					fmt.Sprintf("Unable to make API request: %s", err.Error()),
-				)
-			}
-			return
+			resp.Diagnostics.AddError(
+				"API request failed",
+				fmt.Sprintf("Unable to make API request: %s", err.Error()),
+			)
 		}
+		return
 	}
 
+	filterListResults(functionsResponse, query, allPages)
+
 	// Close response body after successful API call
 	if response != nil {
 		defer response.Body.Close()
 	}
 
 	functionsState := functionsDataSourceModel{
-		Counter: types.Int64Value(*functionsResponse.Count),
+		Counter:  types.Int64Value(*functionsResponse.Count),
+		FetchAll: fetchAll,
+		Filter:   query.Filter,
+		Search:   query.Search,
+		Ordering: query.Ordering,
 	}
 
 	for _, resultFunctions := range functionsResponse.GetResults() {
@@ -247,7 +273,7 @@ func (d *functionsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		functionsState.Results = append(functionsState.Results, result)
 	}
 	functionsState.ID = types.StringValue("Get All Functions")
-	diags := resp.State.Set(ctx, &functionsState)
+	diags = resp.State.Set(ctx, &functionsState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -2,12 +2,12 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,8 +15,9 @@ import (
 )
 
 var (
-	_ datasource.DataSource              = &NetworkListDataSource{}
-	_ datasource.DataSourceWithConfigure = &NetworkListDataSource{}
+	_ datasource.DataSource                     = &NetworkListDataSource{}
+	_ datasource.DataSourceWithConfigure        = &NetworkListDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &NetworkListDataSource{}
 )
 
 func dataSourceAzionNetworkList() datasource.DataSource {
@@ -29,6 +30,7 @@ type NetworkListDataSource struct {
 
 type NetworkListDataSourceModel struct {
 	ID      types.Int64        `tfsdk:"id"`
+	Name    types.String       `tfsdk:"name"`
 	Results *NetworkListResult `tfsdk:"results"`
 }
 
@@ -61,8 +63,14 @@ func (n *NetworkListDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				Description: "Identifier of the network list.",
-				Required:    true,
+				Description: "Identifier of the network list. Conflicts with `name`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the network list, looked up when `id` is not set. It must match exactly one network list.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"results": schema.SingleNestedAttribute{
 				Computed: true,
@@ -118,6 +126,12 @@ func (n *NetworkListDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 	}
 }
 
+func (n *NetworkListDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
 func (n *NetworkListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var networkListID types.Int64
 	diagsID := req.Config.GetAttribute(ctx, path.Root("id"), &networkListID)
@@ -126,46 +140,27 @@ func (n *NetworkListDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	networkListResponse, response, err := n.client.api.NetworkListsAPI.RetrieveNetworkList(ctx, networkListID.ValueInt64()).Execute() //nolint
-	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			networkListResponse, response, err = utils.RetryOn429(func() (*azionapi.NetworkListResponse, *http.Response, error) {
-				return n.client.api.NetworkListsAPI.RetrieveNetworkList(ctx, networkListID.ValueInt64()).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			if response != nil && response.Body != nil {
-				bodyBytes, errReadAll := io.ReadAll(response.Body)
-				if errReadAll != nil {
-					resp.Diagnostics.AddError(
-						errReadAll.Error(),
-						"error reading response from API",
-					)
-				}
-				bodyString := string(bodyBytes)
-				resp.Diagnostics.AddError(
-					err.Error(),
-					bodyString,
-				)
-			} else {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed",
-				)
-			}
+	var name types.String
+	diagsName := req.Config.GetAttribute(ctx, path.Root("name"), &name)
+	resp.Diagnostics.Append(diagsName...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !name.IsNull() {
+		id, ok := lookupIDByName(ctx, "network list", name.ValueString(), func(page, pageSize int64) (*azionapi.PaginatedNetworkListSummaryList, *http.Response, error) {
+			return n.client.api.NetworkListsAPI.ListNetworkLists(ctx).Name(name.ValueString()).Page(page).PageSize(pageSize).Execute() //nolint
+		}, &resp.Diagnostics)
+		if !ok {
 			return
 		}
+		networkListID = types.Int64Value(id)
+	}
+
+	networkListResponse, response, err := n.client.api.NetworkListsAPI.RetrieveNetworkList(ctx, networkListID.ValueInt64()).Execute() //nolint
+	if err != nil {
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
 	networkListState := populateNetworkListResult(networkListResponse.GetData())
@@ -183,7 +178,8 @@ func populateNetworkListResult(data azionapi.NetworkList) NetworkListDataSourceM
 	}
 
 	return NetworkListDataSourceModel{
-		ID: types.Int64Value(data.GetId()),
+		ID:   types.Int64Value(data.GetId()),
+		Name: types.StringValue(data.GetName()),
 		Results: &NetworkListResult{
 			ID:           types.Int64Value(data.GetId()),
 			LastEditor:   types.StringValue(data.GetLastEditor()),
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworkListDataSource(t *testing.T) {
+	server := testAccMockAPI(t)
+	var office map[string]any
+	for _, name := range []string{"office", "branch", "twin", "twin"} {
+		list, err := server.Create("/workspace/network_lists", map[string]any{
+			"name":  name,
+			"type":  "ip_cidr",
+			"items": []any{"10.0.0.0/8"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name == "office" {
+			office = list
+		}
+	}
+	officeID := fmt.Sprint(office["id"])
+	const name = "data.azion_network_list.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfig + `data "azion_network_list" "test" {
+  id   = 1
+  name = "office"
+}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      mockProviderConfig + `data "azion_network_list" "test" {}`,
+				ExpectError: regexp.MustCompile(`Missing Attribute Configuration`),
+			},
+			{
+				Config:      mockProviderConfig + `data "azion_network_list" "test" { name = "offic" }`,
+				ExpectError: regexp.MustCompile(`No network list has\s+the\s+name\s+"offic"\.\s+Candidates\s+with\s+a\s+similar\s+name:\s+"office"\s+\(ID\s+` + officeID + `\)\.`),
+			},
+			{
+				Config:      mockProviderConfig + `data "azion_network_list" "test" { name = "twin" }`,
+				ExpectError: regexp.MustCompile(`2\s+network\s+lists\s+have\s+the\s+name\s+"twin":\s+"twin"\s+\(ID\s+\d+\),\s+"twin"\s+\(ID\s+\d+\)`),
+			},
+			{
+				Config: mockProviderConfig + `data "azion_network_list" "test" { name = "office" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", officeID),
+					resource.TestCheckResourceAttr(name, "name", "office"),
+					resource.TestCheckResourceAttr(name, "results.name", "office"),
+					testAccCheckListQuery(server, "/workspace/network_lists", "name=office"),
+				),
+			},
+			{
+				Config: mockProviderConfig + fmt.Sprintf(`data "azion_network_list" "test" { id = %s }`, officeID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", officeID),
+					resource.TestCheckResourceAttr(name, "name", "office"),
+				),
+			},
+		},
+	})
+}
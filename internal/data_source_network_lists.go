@@ -2,15 +2,16 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -30,6 +31,10 @@ type NetworkListsDataSource struct {
 type NetworkListsDataSourceModel struct {
 	Counter    types.Int64                `tfsdk:"counter"`
 	Page       types.Int64                `tfsdk:"page"`
+	FetchAll   types.Bool                 `tfsdk:"fetch_all"`
+	Filter     types.Object               `tfsdk:"filter"`
+	Search     types.String               `tfsdk:"search"`
+	Ordering   types.String               `tfsdk:"ordering"`
 	TotalPages types.Int64                `tfsdk:"total_pages"`
 	Links      *NetworkListsResponseLinks `tfsdk:"links"`
 	Results    []NetworkListsResults      `tfsdk:"results"`
@@ -80,6 +85,15 @@ func (n *NetworkListsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: "The page number of network lists.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of network lists instead of a single one. Conflicts with `page`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page")),
+				},
+			},
+			"search":   listSearchAttribute("network lists"),
+			"ordering": listOrderingAttribute("network lists"),
 			"total_pages": schema.Int64Attribute{
 				Description: "The total number of pages.",
 				Computed:    true,
@@ -143,6 +157,9 @@ func (n *NetworkListsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.NetworkListSummary]("network lists"),
+		},
 	}
 }
 
@@ -154,6 +171,20 @@ func (n *NetworkListsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	query := readListQuery[azionapi.NetworkListSummary](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListNetworkListsRequest](query, fetchAll, page)
+
 	if page.IsNull() || page.IsUnknown() {
 		page = types.Int64Value(1)
 	}
@@ -164,48 +195,23 @@ func (n *NetworkListsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	networkListsResponse, response, err := n.client.api.NetworkListsAPI.ListNetworkLists(ctx).Page(int64(page32)).Execute() //nolint
+	listRequest := applyListQuery(n.client.api.NetworkListsAPI.ListNetworkLists(ctx), query)
+	var networkListsResponse *azionapi.PaginatedNetworkListSummaryList
+	var response *http.Response
+	if allPages {
+		networkListsResponse, response, err = utils.FetchAll(ctx, func(page, pageSize int64) (*azionapi.PaginatedNetworkListSummaryList, *http.Response, error) {
+			return listRequest.Page(page).PageSize(pageSize).Execute() //nolint
+		})
+	} else {
+		networkListsResponse, response, err = listRequest.Page(int64(page32)).Execute() //nolint
+	}
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			networkListsResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedNetworkListSummaryList, *http.Response, error) {
-				return n.client.api.NetworkListsAPI.ListNetworkLists(ctx).Page(int64(page32)).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			if response != nil && response.Body != nil {
-				bodyBytes, errReadAll := io.ReadAll(response.Body)
-				if errReadAll != nil {
-					resp.Diagnostics.AddError(
-						errReadAll.Error(),
-						"error reading response from API",
-					)
-				}
-				bodyString := string(bodyBytes)
-				resp.Diagnostics.AddError(
-					err.Error(),
-					bodyString,
-				)
-			} else {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed",
-				)
-			}
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
+	filterListResults(networkListsResponse, query, allPages)
+
 	var networkLists []NetworkListsResults
 	for _, nl := range networkListsResponse.GetResults() {
 		networkList := NetworkListsResults{
@@ -230,8 +236,14 @@ func (n *NetworkListsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		Links:      populateNetworkListsLinks(networkListsResponse),
 		Results:    networkLists,
 		ID:         types.StringValue("Get All Network Lists"),
+		FetchAll:   fetchAll,
+		Filter:     query.Filter,
+		Search:     query.Search,
+		Ordering:   query.Ordering,
 	}
 
+	clearPagination(allPages, &networkListsState.Page)
+
 	diags := resp.State.Set(ctx, &networkListsState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/aziontech/terraform-provider-azion/internal/mockapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNetworkListsDataSourceFetchAll(t *testing.T) {
+	server := testAccMockAPI(t)
+	const lists = 205
+	for i := range lists {
+		_, err := server.Create("/workspace/network_lists", map[string]any{
+			"name":  fmt.Sprintf("list-%03d", i),
+			"type":  "ip_cidr",
+			"items": []any{"10.0.0.0/8"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	const name = "data.azion_network_lists.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfig + `data "azion_network_lists" "test" {
+  page      = 2
+  fetch_all = true
+}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: mockProviderConfig + `data "azion_network_lists" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "counter", strconv.Itoa(lists)),
+					resource.TestCheckResourceAttr(name, "results.#", "10"),
+					resource.TestCheckResourceAttr(name, "page", "1"),
+				),
+			},
+			{
+				Config: mockProviderConfig + `data "azion_network_lists" "test" { fetch_all = true }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "counter", strconv.Itoa(lists)),
+					resource.TestCheckResourceAttr(name, "results.#", strconv.Itoa(lists)),
+					resource.TestCheckResourceAttr(name, "results.0.name", "list-000"),
+					resource.TestCheckResourceAttr(name, "results.204.name", "list-204"),
+					resource.TestCheckNoResourceAttr(name, "page"),
+				),
+			},
+			{
+				// The API does not support name_regex, so every page is
+				// fetched to filter by it.
+				Config: mockProviderConfig + `data "azion_network_lists" "test" {
+  filter {
+    name_regex = "-20[0-4]$"
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.#", "5"),
+					resource.TestCheckResourceAttr(name, "results.0.name", "list-200"),
+					resource.TestCheckResourceAttr(name, "counter", "5"),
+					resource.TestCheckResourceAttr(name, "total_pages", "1"),
+					resource.TestCheckNoResourceAttr(name, "page"),
+				),
+			},
+			{
+				// A page requested is the only one filtered, and the counts
+				// still describe the whole list.
+				Config: mockProviderConfig + `data "azion_network_lists" "test" {
+  page = 1
+  filter {
+    name_regex = "-20[0-4]$"
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.#", "0"),
+					resource.TestCheckResourceAttr(name, "counter", strconv.Itoa(lists)),
+					resource.TestCheckResourceAttr(name, "total_pages", "21"),
+					resource.TestCheckResourceAttr(name, "page", "1"),
+				),
+			},
+			{
+				Config: mockProviderConfig + `data "azion_network_lists" "test" {
+  fetch_all = false
+  filter {
+    name_regex = "-00[0-4]$"
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.#", "5"),
+					resource.TestCheckResourceAttr(name, "page", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkListsDataSourceFilter(t *testing.T) {
+	server := testAccMockAPI(t)
+	for _, list := range []map[string]any{
+		{"name": "office", "type": "ip_cidr", "items": []any{"10.0.0.0/8"}},
+		{"name": "office-backup", "type": "ip_cidr", "items": []any{"10.0.0.0/8"}},
+		{"name": "office-asn", "type": "asn", "items": []any{"1234"}},
+		{"name": "office-old", "type": "ip_cidr", "items": []any{"10.0.0.0/8"}, "active": false},
+		{"name": "branch", "type": "ip_cidr", "items": []any{"10.0.0.0/8"}},
+	} {
+		if _, err := server.Create("/workspace/network_lists", list); err != nil {
+			t.Fatal(err)
+		}
+	}
+	const name = "data.azion_network_lists.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfig + `data "azion_network_lists" "test" {
+  filter {
+    name = "office"
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.#", "1"),
+					resource.TestCheckResourceAttr(name, "results.0.name", "office"),
+					testAccCheckListQuery(server, "/workspace/network_lists", "name=office"),
+				),
+			},
+			{
+				Config: mockProviderConfig + `data "azion_network_lists" "test" {
+  search   = "office"
+  ordering = "-name"
+  filter {
+    name_regex = "^office-"
+    type       = "ip_cidr"
+    active     = true
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.#", "1"),
+					resource.TestCheckResourceAttr(name, "results.0.name", "office-backup"),
+					testAccCheckListQuery(server, "/workspace/network_lists", "list_type__in=ip_cidr&ordering=-name&search=office"),
+				),
+			},
+			{
+				Config: mockProviderConfig + `data "azion_network_lists" "test" {
+  filter {
+    last_modified_before = "2000-01-01T00:00:00Z"
+  }
+}`,
+				Check: resource.TestCheckResourceAttr(name, "results.#", "0"),
+			},
+			{
+				// Zones have no type, so their filter has no type either.
+				Config: mockProviderConfig + `data "azion_intelligent_dns_zones" "test" {
+  filter {
+    type = "primary"
+  }
+}`,
+				ExpectError: regexp.MustCompile(`An argument named "type" is not expected here`),
+			},
+			{
+				Config: mockProviderConfig + `data "azion_network_lists" "test" {
+  filter {
+    name_regex = "("
+  }
+}`,
+				ExpectError: regexp.MustCompile(`Invalid regular expression`),
+			},
+			{
+				Config: mockProviderConfig + `data "azion_network_lists" "test" {
+  filter {
+    last_modified_after = "yesterday"
+  }
+}`,
+				ExpectError: regexp.MustCompile(`Invalid timestamp`),
+			},
+		},
+	})
+}
+
+// testAccCheckListQuery checks that the last list request sent to
+// collectionPath had the query parameters of want, among others.
+func testAccCheckListQuery(server *mockapi.Server, collectionPath, want string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		wantQuery, err := url.ParseQuery(want)
+		if err != nil {
+			return err
+		}
+		requests := server.Requests()
+		for i := len(requests) - 1; i >= 0; i-- {
+			request := requests[i]
+			if request.Method != http.MethodGet || request.Path != collectionPath {
+				continue
+			}
+			query, err := url.ParseQuery(request.Query)
+			if err != nil {
+				return err
+			}
+			for key := range wantQuery {
+				if query.Get(key) != wantQuery.Get(key) {
+					return fmt.Errorf("list request query %q has %s=%q, want %q", request.Query, key, query.Get(key), wantQuery.Get(key))
+				}
+			}
+			return nil
+		}
+		return fmt.Errorf("no list request to %s", collectionPath)
+	}
+}
@@ -7,9 +7,11 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,6 +33,10 @@ type RecordsDataSourceModel struct {
 	TotalPages types.Int64              `tfsdk:"total_pages"`
 	Page       types.Int64              `tfsdk:"page"`
 	PageSize   types.Int64              `tfsdk:"page_size"`
+	FetchAll   types.Bool               `tfsdk:"fetch_all"`
+	Filter     types.Object             `tfsdk:"filter"`
+	Search     types.String             `tfsdk:"search"`
+	Ordering   types.String             `tfsdk:"ordering"`
 	Counter    types.Int64              `tfsdk:"counter"`
 	Links      *RecordsResponseLinks    `tfsdk:"links"`
 	Results    []RecordDataSourceResult `tfsdk:"results"`
@@ -82,6 +88,15 @@ func (d *RecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "The page size number of Records.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of records instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("records"),
+			"ordering": listOrderingAttribute("records"),
 			"counter": schema.Int64Attribute{
 				Description: "The total number of records.",
 				Computed:    true,
@@ -141,6 +156,9 @@ func (d *RecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.Record]("records"),
+		},
 	}
 }
 
@@ -161,12 +179,26 @@ func (d *RecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	query := readListQuery[azionapi.Record](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diagsZoneId := req.Config.GetAttribute(ctx, path.Root("zone_id"), &zoneId)
 	resp.Diagnostics.Append(diagsZoneId...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	allPages := listAllPages[azionapi.ApiListDnsRecordsRequest](query, fetchAll, page, pageSize)
+
 	// Set default values for pagination.
 	if page.IsNull() || page.IsUnknown() {
 		page = types.Int64Value(1)
@@ -175,46 +207,44 @@ func (d *RecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		pageSize = types.Int64Value(10)
 	}
 
-	// Build the API request.
-	listRequest := d.client.api.DNSRecordsAPI.ListDnsRecords(ctx, zoneId.ValueInt64()).
-		Page(page.ValueInt64()).
-		PageSize(pageSize.ValueInt64())
-
-	// Execute the request.
-	recordsResponse, httpResp, err := listRequest.Execute() //nolint
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedRecordList, *http.Response, error) {
+		return applyListQuery(d.client.api.DNSRecordsAPI.ListDnsRecords(ctx, zoneId.ValueInt64()), query).
+			Page(page).
+			PageSize(pageSize).
+			Execute() //nolint
+	}
+	var recordsResponse *azionapi.PaginatedRecordList
+	var response *http.Response
+	var err error
+	if allPages {
+		recordsResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		recordsResponse, response, err = fetch(page.ValueInt64(), pageSize.ValueInt64())
+	}
 	if err != nil {
-		if httpResp.StatusCode == 429 {
-			recordsResponse, httpResp, err = utils.RetryOn429(func() (*azionapi.PaginatedRecordList, *http.Response, error) {
-				return d.client.api.DNSRecordsAPI.ListDnsRecords(ctx, zoneId.ValueInt64()).
-					Page(page.ValueInt64()).
-					PageSize(pageSize.ValueInt64()).
-					Execute()
-			}, 5) // Maximum 5 retries
-
-			if httpResp != nil {
-				defer httpResp.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintRecords(httpResp.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintRecords(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
-	if httpResp != nil {
-		defer httpResp.Body.Close()
+	filterListResults(recordsResponse, query, allPages)
+
+	if response != nil {
+		defer response.Body.Close()
 	}
 
 	// Build the state from the response.
 	recordsState := buildRecordsState(zoneId, page, pageSize, recordsResponse)
+	recordsState.FetchAll = fetchAll
+	recordsState.Filter = query.Filter
+	recordsState.Search = query.Search
+	recordsState.Ordering = query.Ordering
+
+	clearPagination(allPages, &recordsState.Page, &recordsState.PageSize)
 
 	// Set the state.
 	diags := resp.State.Set(ctx, &recordsState)
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &StorageObjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &StorageObjectsDataSource{}
+)
+
+func dataSourceAzionStorageObjects() datasource.DataSource {
+	return &StorageObjectsDataSource{}
+}
+
+type StorageObjectsDataSource struct {
+	client *apiClient
+}
+
+type StorageObjectsDataSourceModel struct {
+	Bucket  types.String                 `tfsdk:"bucket"`
+	Prefix  types.String                 `tfsdk:"prefix"`
+	Counter types.Int64                  `tfsdk:"counter"`
+	Results []StorageObjectsResultsModel `tfsdk:"results"`
+	ID      types.String                 `tfsdk:"id"`
+}
+
+type StorageObjectsResultsModel struct {
+	Key          types.String `tfsdk:"key"`
+	Size         types.Int64  `tfsdk:"size"`
+	LastModified types.String `tfsdk:"last_modified"`
+}
+
+func (d *StorageObjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*apiClient)
+}
+
+func (d *StorageObjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_objects"
+}
+
+func (d *StorageObjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+			},
+			"bucket": schema.StringAttribute{
+				Description: "Name of the bucket to list the objects of.",
+				Required:    true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "Prefix the keys of the objects listed start with, such as `assets/`. Objects in the folders under it are listed too.",
+				Optional:    true,
+			},
+			"counter": schema.Int64Attribute{
+				Description: "The count of objects listed.",
+				Computed:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "Key of the object.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "Size of the object, in bytes.",
+							Computed:    true,
+						},
+						"last_modified": schema.StringAttribute{
+							Description: "Last modified timestamp of the object.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StorageObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state StorageObjectsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := state.Bucket.ValueString()
+	objects, response, err := listStorageObjects(ctx, d.client, bucket, state.Prefix.ValueString())
+	if err != nil {
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+
+	results := make([]StorageObjectsResultsModel, len(objects))
+	for i, object := range objects {
+		results[i] = StorageObjectsResultsModel{
+			Key:          types.StringValue(object.Key),
+			Size:         types.Int64Value(object.Size),
+			LastModified: types.StringValue(object.LastModified.Format(time.RFC3339)),
+		}
+	}
+
+	state.ID = types.StringValue(storageObjectID(bucket, state.Prefix.ValueString()))
+	state.Counter = types.Int64Value(int64(len(results)))
+	state.Results = results
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// listStorageObjects returns the objects of bucket whose key starts with
+// prefix, at every level under it, following the continuation tokens that
+// chain the pages of the list. Folders are left out.
+func listStorageObjects(ctx context.Context, client *apiClient, bucket, prefix string) ([]azionapi.BucketObject, *http.Response, error) {
+	var objects []azionapi.BucketObject
+	var continuationToken *string
+	for {
+		listRequest := client.api.StorageObjectsAPI.ListObjects(ctx, bucket).AllLevels(true)
+		if prefix != "" {
+			listRequest = listRequest.Prefix(prefix)
+		}
+		if continuationToken != nil {
+			listRequest = listRequest.ContinuationToken(*continuationToken)
+		}
+		objectsResponse, response, err := listRequest.Execute() //nolint
+		if err != nil {
+			return nil, response, err
+		}
+		response.Body.Close()
+
+		for _, object := range objectsResponse.GetResults() {
+			if !object.IsFolder {
+				objects = append(objects, object)
+			}
+		}
+
+		continuationToken = objectsResponse.ContinuationToken.Get()
+		if continuationToken == nil || *continuationToken == "" || len(objectsResponse.GetResults()) == 0 {
+			return objects, nil, nil
+		}
+	}
+}
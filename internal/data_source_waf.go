@@ -2,8 +2,6 @@ package provider
 
 import (
 	"context"
-	"io"
-	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
@@ -196,37 +194,8 @@ func (o *WafDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 
 	wafResponse, response, err := o.client.api.WAFsAPI.RetrieveWaf(ctx, wafID.ValueInt64()).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			wafResponse, response, err = utils.RetryOn429(func() (*azionapi.WAFResponse, *http.Response, error) {
-				return o.client.api.WAFsAPI.RetrieveWaf(ctx, wafID.ValueInt64()).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
 	if response != nil {
@@ -2,15 +2,16 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -34,6 +35,10 @@ type WafRuleSetsDataSourceModel struct {
 	TotalPages types.Int64                   `tfsdk:"total_pages"`
 	Page       types.Int64                   `tfsdk:"page"`
 	PageSize   types.Int64                   `tfsdk:"page_size"`
+	FetchAll   types.Bool                    `tfsdk:"fetch_all"`
+	Filter     types.Object                  `tfsdk:"filter"`
+	Search     types.String                  `tfsdk:"search"`
+	Ordering   types.String                  `tfsdk:"ordering"`
 	Links      *WafRuleSetsResponseLinks     `tfsdk:"links"`
 	Results    []WafRuleSetListItemDataModel `tfsdk:"results"`
 }
@@ -89,6 +94,15 @@ func (o *WafRuleSetsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Description: "The page size number.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of WAF exceptions instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("WAF exceptions"),
+			"ordering": listOrderingAttribute("WAF exceptions"),
 			"total_pages": schema.Int64Attribute{
 				Description: "The total number of pages.",
 				Computed:    true,
@@ -174,6 +188,9 @@ func (o *WafRuleSetsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.WAFRule]("WAF exceptions"),
+		},
 	}
 }
 
@@ -198,6 +215,20 @@ func (o *WafRuleSetsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	query := readListQuery[azionapi.WAFRule](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListWafExceptionsRequest](query, fetchAll, page, pageSize)
+
 	if page.IsNull() || page.IsUnknown() || page.ValueInt64() == 0 {
 		page = types.Int64Value(1)
 	}
@@ -205,41 +236,24 @@ func (o *WafRuleSetsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		pageSize = types.Int64Value(10)
 	}
 
-	listResponse, response, err := o.client.api.WAFsExceptionsAPI.ListWafExceptions(ctx, wafID.ValueInt64()).Page(page.ValueInt64()).PageSize(pageSize.ValueInt64()).Execute()
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedWAFRuleList, *http.Response, error) {
+		return applyListQuery(o.client.api.WAFsExceptionsAPI.ListWafExceptions(ctx, wafID.ValueInt64()), query).Page(page).PageSize(pageSize).Execute()
+	}
+	var listResponse *azionapi.PaginatedWAFRuleList
+	var response *http.Response
+	var err error
+	if allPages {
+		listResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		listResponse, response, err = fetch(page.ValueInt64(), pageSize.ValueInt64())
+	}
 	if err != nil {
-		if response.StatusCode == 429 {
-			listResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedWAFRuleList, *http.Response, error) {
-				return o.client.api.WAFsExceptionsAPI.ListWafExceptions(ctx, wafID.ValueInt64()).Page(page.ValueInt64()).PageSize(pageSize.ValueInt64()).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
+	filterListResults(listResponse, query, allPages)
+
 	if response != nil {
 		defer response.Body.Close()
 	}
@@ -266,6 +280,10 @@ func (o *WafRuleSetsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		TotalPages: types.Int64Value(listResponse.GetTotalPages()),
 		Page:       page,
 		PageSize:   pageSize,
+		FetchAll:   fetchAll,
+		Filter:     query.Filter,
+		Search:     query.Search,
+		Ordering:   query.Ordering,
 		Counter:    types.Int64Value(listResponse.GetCount()),
 		Links: &WafRuleSetsResponseLinks{
 			Previous: types.StringValue(previous),
@@ -273,6 +291,8 @@ func (o *WafRuleSetsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		},
 	}
 
+	clearPagination(allPages, &state.Page, &state.PageSize)
+
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
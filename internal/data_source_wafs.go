@@ -2,15 +2,16 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,6 +34,10 @@ type WafsDataSourceModel struct {
 	TotalPages types.Int64        `tfsdk:"total_pages"`
 	Page       types.Int64        `tfsdk:"page"`
 	PageSize   types.Int64        `tfsdk:"page_size"`
+	FetchAll   types.Bool         `tfsdk:"fetch_all"`
+	Filter     types.Object       `tfsdk:"filter"`
+	Search     types.String       `tfsdk:"search"`
+	Ordering   types.String       `tfsdk:"ordering"`
 	Links      *WafsResponseLinks `tfsdk:"links"`
 	Results    []WafListItemModel `tfsdk:"results"`
 }
@@ -86,6 +91,15 @@ func (o *WafsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: "The page size number.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of WAFs instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("WAFs"),
+			"ordering": listOrderingAttribute("WAFs"),
 			"total_pages": schema.Int64Attribute{
 				Description: "The total number of pages.",
 				Computed:    true,
@@ -196,6 +210,9 @@ func (o *WafsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.WAF]("WAFs"),
+		},
 	}
 }
 
@@ -214,6 +231,20 @@ func (o *WafsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	query := readListQuery[azionapi.WAF](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListWafsRequest](query, fetchAll, page, pageSize)
+
 	if page.IsNull() || page.IsUnknown() || page.ValueInt64() == 0 {
 		page = types.Int64Value(1)
 	}
@@ -221,41 +252,24 @@ func (o *WafsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		pageSize = types.Int64Value(10)
 	}
 
-	listResponse, response, err := o.client.api.WAFsAPI.ListWafs(ctx).Page(page.ValueInt64()).PageSize(pageSize.ValueInt64()).Execute()
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedWAFList, *http.Response, error) {
+		return applyListQuery(o.client.api.WAFsAPI.ListWafs(ctx), query).Page(page).PageSize(pageSize).Execute()
+	}
+	var listResponse *azionapi.PaginatedWAFList
+	var response *http.Response
+	var err error
+	if allPages {
+		listResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		listResponse, response, err = fetch(page.ValueInt64(), pageSize.ValueInt64())
+	}
 	if err != nil {
-		if response.StatusCode == 429 {
-			listResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedWAFList, *http.Response, error) {
-				return o.client.api.WAFsAPI.ListWafs(ctx).Page(page.ValueInt64()).PageSize(pageSize.ValueInt64()).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
+	filterListResults(listResponse, query, allPages)
+
 	if response != nil {
 		defer response.Body.Close()
 	}
@@ -281,6 +295,10 @@ func (o *WafsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		TotalPages: types.Int64Value(listResponse.GetTotalPages()),
 		Page:       page,
 		PageSize:   pageSize,
+		FetchAll:   fetchAll,
+		Filter:     query.Filter,
+		Search:     query.Search,
+		Ordering:   query.Ordering,
 		Counter:    types.Int64Value(listResponse.GetCount()),
 		Links: &WafsResponseLinks{
 			Previous: types.StringValue(previous),
@@ -288,6 +306,8 @@ func (o *WafsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		},
 	}
 
+	clearPagination(allPages, &state.Page, &state.PageSize)
+
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -9,6 +9,7 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,8 +17,9 @@ import (
 )
 
 var (
-	_ datasource.DataSource              = &WorkloadDataSource{}
-	_ datasource.DataSourceWithConfigure = &WorkloadDataSource{}
+	_ datasource.DataSource                     = &WorkloadDataSource{}
+	_ datasource.DataSourceWithConfigure        = &WorkloadDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &WorkloadDataSource{}
 )
 
 func dataSourceAzionWorkload() datasource.DataSource {
@@ -31,6 +33,7 @@ type WorkloadDataSource struct {
 type WorkloadDataSourceModel struct {
 	Data WorkloadResults `tfsdk:"data"`
 	ID   types.String    `tfsdk:"id"`
+	Name types.String    `tfsdk:"name"`
 }
 
 type WorkloadResults struct {
@@ -93,8 +96,14 @@ func (d *WorkloadDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Numeric identifier of the data source.",
-				Required:    true,
+				Description: "Numeric identifier of the workload. Conflicts with `name`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the workload, looked up when `id` is not set. It must match exactly one workload.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"data": schema.SingleNestedAttribute{
 				Computed: true,
@@ -229,6 +238,12 @@ func (d *WorkloadDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	}
 }
 
+func (d *WorkloadDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
 func (d *WorkloadDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var getWorkloadId types.String
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &getWorkloadId)
@@ -237,39 +252,44 @@ func (d *WorkloadDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	workloadID, err := strconv.ParseInt(getWorkloadId.ValueString(), 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Value Conversion error ",
-			"Could not convert ID",
-		)
+	var name types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("name"), &name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var workloadID int64
+	var err error
+	if !name.IsNull() {
+		var ok bool
+		workloadID, ok = lookupIDByName(ctx, "workload", name.ValueString(), func(page, pageSize int64) (*azionapi.PaginatedWorkloadList, *http.Response, error) {
+			return d.client.api.WorkloadsAPI.ListWorkloads(ctx).Name(name.ValueString()).Page(page).PageSize(pageSize).Execute() //nolint
+		}, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+	} else {
+		workloadID, err = strconv.ParseInt(getWorkloadId.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Value Conversion error ",
+				"Could not convert ID",
+			)
+			return
+		}
+	}
+
 	workloadResponse, response, err := d.client.api.WorkloadsAPI.
 		RetrieveWorkload(ctx, workloadID).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			workloadResponse, response, err = utils.RetryOn429(func() (*azionapi.WorkloadResponse, *http.Response, error) {
-				return d.client.api.WorkloadsAPI.RetrieveWorkload(ctx, workloadID).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintWorkload(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintWorkload(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
 	workloadState := WorkloadDataSourceModel{
@@ -424,7 +444,8 @@ func (d *WorkloadDataSource) Read(ctx context.Context, req datasource.ReadReques
 		workloadState.Data.Domains = types.ListNull(types.StringType)
 	}
 
-	workloadState.ID = types.StringValue("Get By Id Workload")
+	workloadState.ID = types.StringValue(strconv.FormatInt(workloadID, 10))
+	workloadState.Name = workloadState.Data.Name
 	diags = resp.State.Set(ctx, &workloadState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
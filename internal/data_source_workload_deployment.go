@@ -3,11 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strconv"
 	"time"
 
-	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -184,27 +182,13 @@ func (d *WorkloadDeploymentDataSource) Read(ctx context.Context, req datasource.
 	deploymentResponse, response, err := d.client.api.WorkloadDeploymentsAPI.
 		RetrieveWorkloadDeployment(ctx, deploymentID, workloadID).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			deploymentResponse, response, err = utils.RetryOn429(func() (*azionapi.WorkloadDeploymentResponse, *http.Response, error) {
-				return d.client.api.WorkloadDeploymentsAPI.RetrieveWorkloadDeployment(ctx, deploymentID, workloadID).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintWorkloadDeployment(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintWorkloadDeployment(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
 	deploymentState := WorkloadDeploymentDataSourceModel{
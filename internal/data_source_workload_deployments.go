@@ -31,6 +31,10 @@ type WorkloadDeploymentsDataSource struct {
 type WorkloadDeploymentsDataSourceModel struct {
 	WorkloadID       types.String                      `tfsdk:"workload_id"`
 	DeploymentsCount types.Int64                       `tfsdk:"deployments_count"`
+	FetchAll         types.Bool                        `tfsdk:"fetch_all"`
+	Filter           types.Object                      `tfsdk:"filter"`
+	Search           types.String                      `tfsdk:"search"`
+	Ordering         types.String                      `tfsdk:"ordering"`
 	Results          []WorkloadDeploymentsResultsModel `tfsdk:"results"`
 	ID               types.String                      `tfsdk:"id"`
 }
@@ -83,6 +87,12 @@ func (d *WorkloadDeploymentsDataSource) Schema(_ context.Context, _ datasource.S
 				Description: "The total number of deployments.",
 				Computed:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of deployments instead of the first one.",
+				Optional:    true,
+			},
+			"search":   listSearchAttribute("deployments"),
+			"ordering": listOrderingAttribute("deployments"),
 			"results": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -147,6 +157,9 @@ func (d *WorkloadDeploymentsDataSource) Schema(_ context.Context, _ datasource.S
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.WorkloadDeployment]("deployments"),
+		},
 	}
 }
 
@@ -167,34 +180,48 @@ func (d *WorkloadDeploymentsDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	deploymentsResponse, response, err := d.client.api.WorkloadDeploymentsAPI.
-		ListWorkloadDeployments(ctx, workloadID).Execute() //nolint
-	if err != nil {
-		if response.StatusCode == 429 {
-			deploymentsResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedWorkloadDeploymentList, *http.Response, error) {
-				return d.client.api.WorkloadDeploymentsAPI.ListWorkloadDeployments(ctx, workloadID).Execute() //nolint
-			}, 5) // Maximum 5 retries
+	query := readListQuery[azionapi.WorkloadDeployment](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if response != nil {
-				defer response.Body.Close()
-			}
+	var fetchAll types.Bool
+	diags = req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintWorkloadDeployments(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+	allPages := listAllPages[azionapi.ApiListWorkloadDeploymentsRequest](query, fetchAll)
+
+	listRequest := applyListQuery(d.client.api.WorkloadDeploymentsAPI.ListWorkloadDeployments(ctx, workloadID), query)
+	var deploymentsResponse *azionapi.PaginatedWorkloadDeploymentList
+	var response *http.Response
+	if allPages {
+		deploymentsResponse, response, err = utils.FetchAll(ctx, func(page, pageSize int64) (*azionapi.PaginatedWorkloadDeploymentList, *http.Response, error) {
+			return listRequest.Page(page).PageSize(pageSize).Execute() //nolint
+		})
+	} else {
+		deploymentsResponse, response, err = listRequest.Execute() //nolint
+	}
+	if err != nil {
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintWorkloadDeployments(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
+	filterListResults(deploymentsResponse, query, allPages)
+
 	deploymentsState := WorkloadDeploymentsDataSourceModel{
 		WorkloadID: getWorkloadId,
+		FetchAll:   fetchAll,
+		Filter:     query.Filter,
+		Search:     query.Search,
+		Ordering:   query.Ordering,
 	}
 
 	if deploymentsResponse.Count != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,9 +28,13 @@ type WorkloadsDataSource struct {
 }
 
 type WorkloadsDataSourceModel struct {
-	Counter types.Int64        `tfsdk:"counter"`
-	Results []WorkloadsResults `tfsdk:"results"`
-	ID      types.String       `tfsdk:"id"`
+	Counter  types.Int64        `tfsdk:"counter"`
+	FetchAll types.Bool         `tfsdk:"fetch_all"`
+	Filter   types.Object       `tfsdk:"filter"`
+	Search   types.String       `tfsdk:"search"`
+	Ordering types.String       `tfsdk:"ordering"`
+	Results  []WorkloadsResults `tfsdk:"results"`
+	ID       types.String       `tfsdk:"id"`
 }
 
 type WorkloadsResults struct {
@@ -77,6 +82,12 @@ func (d *WorkloadsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Description: "The total count of workloads.",
 				Computed:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of workloads instead of the first one.",
+				Optional:    true,
+			},
+			"search":   listSearchAttribute("workloads"),
+			"ordering": listOrderingAttribute("workloads"),
 			"results": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -209,36 +220,56 @@ func (d *WorkloadsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.Workload]("workloads"),
+		},
 	}
 }
 
 func (d *WorkloadsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	workloadsResponse, response, err := d.client.api.WorkloadsAPI.ListWorkloads(ctx).Execute() //nolint
-	if err != nil {
-		if response.StatusCode == 429 {
-			workloadsResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedWorkloadList, *http.Response, error) {
-				return d.client.api.WorkloadsAPI.ListWorkloads(ctx).Execute() //nolint
-			}, 5) // Maximum 5 retries
+	query := readListQuery[azionapi.Workload](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if response != nil {
-				defer response.Body.Close()
-			}
+	var fetchAll types.Bool
+	diags := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintWorkloads(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+	allPages := listAllPages[azionapi.ApiListWorkloadsRequest](query, fetchAll)
+
+	listRequest := applyListQuery(d.client.api.WorkloadsAPI.ListWorkloads(ctx), query)
+	var workloadsResponse *azionapi.PaginatedWorkloadList
+	var response *http.Response
+	var err error
+	if allPages {
+		workloadsResponse, response, err = utils.FetchAll(ctx, func(page, pageSize int64) (*azionapi.PaginatedWorkloadList, *http.Response, error) {
+			return listRequest.Page(page).PageSize(pageSize).Execute() //nolint
+		})
+	} else {
+		workloadsResponse, response, err = listRequest.Execute() //nolint
+	}
+	if err != nil {
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintWorkloads(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
-	workloadsState := WorkloadsDataSourceModel{}
+	filterListResults(workloadsResponse, query, allPages)
+
+	workloadsState := WorkloadsDataSourceModel{
+		FetchAll: fetchAll,
+		Filter:   query.Filter,
+		Search:   query.Search,
+		Ordering: query.Ordering,
+	}
 
 	if workloadsResponse.Count != nil {
 		workloadsState.Counter = types.Int64Value(*workloadsResponse.Count)
@@ -387,7 +418,7 @@ func (d *WorkloadsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	workloadsState.ID = types.StringValue("Get All Workloads")
-	diags := resp.State.Set(ctx, &workloadsState)
+	diags = resp.State.Set(ctx, &workloadsState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -6,8 +6,9 @@ import (
 	"net/http"
 	"strconv"
 
-	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	"github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,8 +16,9 @@ import (
 )
 
 var (
-	_ datasource.DataSource              = &ZoneDataSource{}
-	_ datasource.DataSourceWithConfigure = &ZoneDataSource{}
+	_ datasource.DataSource                     = &ZoneDataSource{}
+	_ datasource.DataSourceWithConfigure        = &ZoneDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ZoneDataSource{}
 )
 
 func dataSourceAzionZone() datasource.DataSource {
@@ -28,8 +30,9 @@ type ZoneDataSource struct {
 }
 
 type ZoneDataSourceModel struct {
-	Data ZoneModel    `tfsdk:"data"`
-	ID   types.String `tfsdk:"id"`
+	Data   ZoneModel    `tfsdk:"data"`
+	ID     types.String `tfsdk:"id"`
+	Domain types.String `tfsdk:"domain"`
 }
 
 type ZoneModel struct {
@@ -56,8 +59,14 @@ func (d *ZoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Numeric identifier of the data source.",
-				Required:    true,
+				Description: "Numeric identifier of the zone. Conflicts with `domain`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "Domain of the zone, looked up when `id` is not set. It must match exactly one zone.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"data": schema.SingleNestedAttribute{
 				Computed: true,
@@ -93,6 +102,12 @@ func (d *ZoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 	}
 }
 
+func (d *ZoneDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("domain")),
+	}
+}
+
 func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var getZoneId types.String
 	diags := req.Config.GetAttribute(ctx, path.Root("id"), &getZoneId)
@@ -101,38 +116,49 @@ func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	zoneId, err := strconv.ParseInt(getZoneId.ValueString(), 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Value Conversion error ",
-			"Could not convert ID",
-		)
+	var domain types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("domain"), &domain)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	zoneResponse, response, err := d.client.api.DNSZonesAPI.RetrieveDnsZone(ctx, zoneId).Execute()
-	if err != nil {
-		if response.StatusCode == 429 {
-			zoneResponse, response, err = utils.RetryOn429(func() (*azionapi.ZoneResponse, *http.Response, error) {
-				return d.client.api.DNSZonesAPI.RetrieveDnsZone(ctx, zoneId).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
+	var zoneId int64
+	var err error
+	if !domain.IsNull() {
+		var ok bool
+		zoneId, ok = lookupID(ctx, "zone", "domain", domain.ValueString(), func(item any) (string, bool) {
+			zone, ok := item.(domainGetter)
+			if !ok {
+				return "", false
 			}
+			return zone.GetDomain(), true
+		}, func(page, pageSize int64) (*azionapi.PaginatedZoneList, *http.Response, error) {
+			return d.client.api.DNSZonesAPI.ListDnsZones(ctx).Domain(domain.ValueString()).Page(page).PageSize(pageSize).Execute() //nolint
+		}, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+	} else {
+		zoneId, err = strconv.ParseInt(getZoneId.ValueString(), 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Value Conversion error ",
+				"Could not convert ID",
+			)
+			return
+		}
+	}
 
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintZone(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+	zoneResponse, response, err := d.client.api.DNSZonesAPI.RetrieveDnsZone(ctx, zoneId).Execute()
+	if err != nil {
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintZone(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
 	if response != nil {
@@ -168,7 +194,8 @@ func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		},
 	}
 
-	zoneState.ID = types.StringValue("Get By ID Zone")
+	zoneState.ID = types.StringValue(strconv.FormatInt(zoneId, 10))
+	zoneState.Domain = types.StringValue(zoneData.GetDomain())
 	diags = resp.State.Set(ctx, &zoneState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
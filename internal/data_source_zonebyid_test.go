@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -25,8 +26,73 @@ func TestAccZonebyIdDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.azion_intelligent_dns_zone.test", "results.is_active", "true"),
 					resource.TestCheckResourceAttr("data.azion_intelligent_dns_zone.test", "results.refresh", "43200"),
 					resource.TestCheckResourceAttr("data.azion_intelligent_dns_zone.test", "results.expiry", "1209600"),
-					// Verify placeholder id attribute
-					resource.TestCheckResourceAttr("data.azion_intelligent_dns_zone.test", "id", "Get By ID Zone"),
+					resource.TestCheckResourceAttr("data.azion_intelligent_dns_zone.test", "id", "2580"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccZoneDataSourceByDomain(t *testing.T) {
+	testAccMockAPI(t)
+	const name = "data.azion_intelligent_dns_zone.by_domain"
+	zones := mockProviderConfig + `
+resource "azion_intelligent_dns_zone" "first" {
+  zone = {
+    name   = "first"
+    domain = "example.com"
+    active = true
+  }
+}
+
+resource "azion_intelligent_dns_zone" "second" {
+  zone = {
+    name   = "second"
+    domain = "shop.example.com"
+    active = true
+  }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      mockProviderConfig + `data "azion_intelligent_dns_zone" "by_domain" {}`,
+				ExpectError: regexp.MustCompile(`Missing Attribute Configuration`),
+			},
+			{
+				Config: zones + `
+data "azion_intelligent_dns_zone" "by_domain" {
+  domain     = "example"
+  depends_on = [azion_intelligent_dns_zone.first, azion_intelligent_dns_zone.second]
+}
+`,
+				ExpectError: regexp.MustCompile(`No\s+zone\s+has\s+the\s+domain\s+"example"\.\s+Candidates\s+with\s+a\s+similar\s+domain:\s+"[a-z.]*example\.com"`),
+			},
+			{
+				Config: zones + `
+data "azion_intelligent_dns_zone" "by_domain" {
+  domain     = "example.com"
+  depends_on = [azion_intelligent_dns_zone.first, azion_intelligent_dns_zone.second]
+}
+
+resource "azion_intelligent_dns_record" "test" {
+  zone_id = data.azion_intelligent_dns_zone.by_domain.id
+  record = {
+    type   = "A"
+    name   = "www"
+    rdata  = ["192.0.2.1"]
+    policy = "simple"
+    ttl    = 20
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "azion_intelligent_dns_zone.first", "id"),
+					resource.TestCheckResourceAttr(name, "domain", "example.com"),
+					resource.TestCheckResourceAttr(name, "data.name", "first"),
+					resource.TestCheckResourceAttrPair("azion_intelligent_dns_record.test", "zone_id", "azion_intelligent_dns_zone.first", "id"),
 				),
 			},
 		},
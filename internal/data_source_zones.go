@@ -7,10 +7,12 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -32,6 +34,10 @@ type ZonesDataSourceModel struct {
 	TotalPages types.Int64         `tfsdk:"total_pages"`
 	Page       types.Int64         `tfsdk:"page"`
 	PageSize   types.Int64         `tfsdk:"page_size"`
+	FetchAll   types.Bool          `tfsdk:"fetch_all"`
+	Filter     types.Object        `tfsdk:"filter"`
+	Search     types.String        `tfsdk:"search"`
+	Ordering   types.String        `tfsdk:"ordering"`
 	Links      *ZonesResponseLinks `tfsdk:"links"`
 	Results    []ZonesModel        `tfsdk:"results"`
 	ID         types.String        `tfsdk:"id"`
@@ -77,6 +83,15 @@ func (d *ZonesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description: "The page size number of Zones.",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "Whether to fetch every page of zones instead of a single one. Conflicts with `page` and `page_size`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("page"), path.MatchRoot("page_size")),
+				},
+			},
+			"search":   listSearchAttribute("zones"),
+			"ordering": listOrderingAttribute("zones"),
 			"total_count": schema.Int64Attribute{
 				Description: "The total number of zones.",
 				Computed:    true,
@@ -129,6 +144,9 @@ func (d *ZonesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock[azionapi.Zone]("zones"),
+		},
 	}
 }
 
@@ -147,6 +165,20 @@ func (d *ZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	query := readListQuery[azionapi.Zone](ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fetchAll types.Bool
+	diagsFetchAll := req.Config.GetAttribute(ctx, path.Root("fetch_all"), &fetchAll)
+	resp.Diagnostics.Append(diagsFetchAll...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allPages := listAllPages[azionapi.ApiListDnsZonesRequest](query, fetchAll, Page, PageSize)
+
 	if Page.IsNull() || Page.IsUnknown() {
 		Page = types.Int64Value(1)
 	}
@@ -155,37 +187,32 @@ func (d *ZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		PageSize = types.Int64Value(10)
 	}
 
-	zoneResponse, response, err := d.client.api.DNSZonesAPI.ListDnsZones(ctx).
-		Page(Page.ValueInt64()).
-		PageSize(PageSize.ValueInt64()).
-		Execute()
+	fetch := func(page, pageSize int64) (*azionapi.PaginatedZoneList, *http.Response, error) {
+		return applyListQuery(d.client.api.DNSZonesAPI.ListDnsZones(ctx), query).
+			Page(page).
+			PageSize(pageSize).
+			Execute()
+	}
+	var zoneResponse *azionapi.PaginatedZoneList
+	var response *http.Response
+	var err error
+	if allPages {
+		zoneResponse, response, err = utils.FetchAll(ctx, fetch)
+	} else {
+		zoneResponse, response, err = fetch(Page.ValueInt64(), PageSize.ValueInt64())
+	}
 	if err != nil {
-		if response.StatusCode == 429 {
-			zoneResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedZoneList, *http.Response, error) {
-				return d.client.api.DNSZonesAPI.ListDnsZones(ctx).
-					Page(Page.ValueInt64()).
-					PageSize(PageSize.ValueInt64()).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintZones(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response == nil {
+			utils.AddAPIError(&resp.Diagnostics, err, response)
 			return
 		}
+		usrMsg, errMsg := errPrintZones(response.StatusCode, err)
+		resp.Diagnostics.AddError(usrMsg, errMsg)
+		return
 	}
 
+	filterListResults(zoneResponse, query, allPages)
+
 	if response != nil {
 		defer response.Body.Close()
 	}
@@ -193,6 +220,10 @@ func (d *ZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	zoneState := ZonesDataSourceModel{
 		Page:     types.Int64Value(Page.ValueInt64()),
 		PageSize: types.Int64Value(PageSize.ValueInt64()),
+		FetchAll: fetchAll,
+		Filter:   query.Filter,
+		Search:   query.Search,
+		Ordering: query.Ordering,
 	}
 
 	// Set optional pagination fields
@@ -253,6 +284,8 @@ func (d *ZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	zoneState.ID = types.StringValue("Get All Zones")
+	clearPagination(allPages, &zoneState.Page, &zoneState.PageSize)
+
 	diags := resp.State.Set(ctx, &zoneState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,455 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listFilter holds the arguments of the filter block of the plural data
+// sources. The block only has the arguments matching a field of the objects
+// listed, so the others stay null.
+type listFilter struct {
+	Name               types.String
+	NameRegex          types.String
+	Active             types.Bool
+	Type               types.String
+	LastModifiedAfter  types.String
+	LastModifiedBefore types.String
+}
+
+// listQuery holds the filter, search and ordering arguments of a plural data
+// source. Filter is the filter block as configured, to save in the state, and
+// filter the same block parsed.
+type listQuery struct {
+	Filter   types.Object
+	Search   types.String
+	Ordering types.String
+
+	filter    *listFilter
+	nameRegex *regexp.Regexp
+	after     *time.Time
+	before    *time.Time
+}
+
+// listSearchAttribute returns the search argument of the plural data sources
+// listing the given objects.
+func listSearchAttribute(objects string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("Search term sent to the API to narrow down the %s.", objects),
+		Optional:    true,
+	}
+}
+
+// listOrderingAttribute returns the ordering argument of the plural data
+// sources listing the given objects.
+func listOrderingAttribute(objects string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("Field to order the %s by, such as `name`. Prefix it with `-` for a descending order.", objects),
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// listFilterBlock returns the filter block of the plural data sources listing
+// the given objects of type T. The block only offers the filters matching a
+// field of T.
+func listFilterBlock[T any](objects string) schema.SingleNestedBlock {
+	var zero T
+	item := listItem(&zero)
+	attributes := map[string]schema.Attribute{}
+	if implements[nameGetter](item) {
+		attributes["name"] = schema.StringAttribute{
+			Description: "Exact name to match.",
+			Optional:    true,
+		}
+		attributes["name_regex"] = schema.StringAttribute{
+			Description: "Regular expression, in RE2 syntax, the name must match.",
+			Optional:    true,
+		}
+	}
+	if implements[activeGetter](item) {
+		attributes["active"] = schema.BoolAttribute{
+			Description: "Active status to match.",
+			Optional:    true,
+		}
+	}
+	if implements[typeGetter](item) {
+		attributes["type"] = schema.StringAttribute{
+			Description: "Type to match.",
+			Optional:    true,
+		}
+	}
+	if implements[lastModifiedGetter](item) {
+		attributes["last_modified_after"] = schema.StringAttribute{
+			Description: "Matches what was last modified at or after this RFC 3339 timestamp.",
+			Optional:    true,
+		}
+		attributes["last_modified_before"] = schema.StringAttribute{
+			Description: "Matches what was last modified at or before this RFC 3339 timestamp.",
+			Optional:    true,
+		}
+	}
+	return schema.SingleNestedBlock{
+		Description: fmt.Sprintf("Filters the %s returned. When a filter is not supported by the API, such as `name`, "+
+			"which the API matches partially, every page is fetched so that it applies to all the %s, "+
+			"unless `fetch_all` is false or a page is requested. The counts of results and pages then describe the "+
+			"filtered %s, but when a single page is read they describe the whole list.", objects, objects, objects),
+		Attributes: attributes,
+	}
+}
+
+// readListQuery reads the filter, search and ordering arguments from config.
+// The filter block only has the filters of T, the objects listed, as built by
+// listFilterBlock.
+func readListQuery[T any](ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) listQuery {
+	var query listQuery
+	diags.Append(config.GetAttribute(ctx, path.Root("filter"), &query.Filter)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("search"), &query.Search)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("ordering"), &query.Ordering)...)
+	if diags.HasError() || query.Filter.IsNull() {
+		return query
+	}
+	attributes := query.Filter.Attributes()
+	stringAttribute := func(name string) types.String {
+		if value, ok := attributes[name].(types.String); ok {
+			return value
+		}
+		return types.StringNull()
+	}
+	active, ok := attributes["active"].(types.Bool)
+	if !ok {
+		active = types.BoolNull()
+	}
+	filter := &listFilter{
+		Name:               stringAttribute("name"),
+		NameRegex:          stringAttribute("name_regex"),
+		Active:             active,
+		Type:               stringAttribute("type"),
+		LastModifiedAfter:  stringAttribute("last_modified_after"),
+		LastModifiedBefore: stringAttribute("last_modified_before"),
+	}
+	query.filter = filter
+
+	if value := filter.NameRegex.ValueString(); value != "" {
+		re, err := regexp.Compile(value)
+		if err != nil {
+			diags.AddAttributeError(path.Root("filter").AtName("name_regex"), "Invalid regular expression", err.Error())
+		}
+		query.nameRegex = re
+	}
+	for _, bound := range []struct {
+		name  string
+		value types.String
+		time  **time.Time
+	}{
+		{"last_modified_after", filter.LastModifiedAfter, &query.after},
+		{"last_modified_before", filter.LastModifiedBefore, &query.before},
+	} {
+		if bound.value.IsNull() || bound.value.IsUnknown() {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, bound.value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("filter").AtName(bound.name), "Invalid timestamp",
+				fmt.Sprintf("Expected an RFC 3339 timestamp such as 2024-01-31T15:04:05Z: %s", err))
+			continue
+		}
+		*bound.time = &t
+	}
+	return query
+}
+
+// listAllPages reports whether to fetch every page of the objects listed by
+// the list request R: when fetchAll is true, or when it and the pagination
+// arguments given are null and the filter of query has fields R cannot send
+// to the API. Those are only matched on the pages fetched, so a single page
+// would miss the matches on the others.
+func listAllPages[R any](query listQuery, fetchAll types.Bool, pagination ...types.Int64) bool {
+	if !fetchAll.IsNull() {
+		return fetchAll.ValueBool()
+	}
+	for _, value := range pagination {
+		if !value.IsNull() {
+			return false
+		}
+	}
+	return query.filtersClientSide(reflect.TypeFor[R]())
+}
+
+// filtersClientSide reports whether the filter has fields the list request of
+// type r cannot send to the API. Names always are, as the API matches them
+// partially.
+func (q listQuery) filtersClientSide(r reflect.Type) bool {
+	filter := q.filter
+	if filter == nil {
+		return false
+	}
+	sends := func(methods ...string) bool {
+		for _, method := range methods {
+			if _, ok := r.MethodByName(method); ok {
+				return true
+			}
+		}
+		return false
+	}
+	return !filter.Name.IsNull() || q.nameRegex != nil ||
+		!filter.Active.IsNull() && !sends("Active") ||
+		!filter.Type.IsNull() && !sends("TypeIn", "ListTypeIn") ||
+		q.after != nil && !sends("LastModifiedGte") ||
+		q.before != nil && !sends("LastModifiedLte")
+}
+
+type nameGetter interface{ GetName() string }
+
+type activeGetter interface{ GetActive() bool }
+
+type typeGetter interface{ GetType() string }
+
+type lastModifiedGetter interface{ GetLastModified() time.Time }
+
+// actualInstanceGetter is implemented by the oneOf models of the SDK, such
+// as azionapi.Connector, which wrap one of several models.
+type actualInstanceGetter interface{ GetActualInstance() interface{} }
+
+func implements[I any](item any) bool {
+	_, ok := item.(I)
+	return ok
+}
+
+// listItem returns the model holding the fields of item, a pointer to an
+// object listed: item itself, or the model a oneOf wraps. The oneOf models
+// of a zero item are empty, so their first model stands for them.
+func listItem(item any) any {
+	oneOf, ok := item.(actualInstanceGetter)
+	if !ok {
+		return item
+	}
+	if instance := oneOf.GetActualInstance(); instance != nil {
+		return instance
+	}
+	return zeroOneOfModel(item)
+}
+
+// zeroOneOfModel returns a zero value of the first model the oneOf item
+// wraps, as a pointer.
+func zeroOneOfModel(item any) any {
+	t := reflect.TypeOf(item).Elem()
+	if t.Kind() != reflect.Struct || t.NumField() == 0 || t.Field(0).Type.Kind() != reflect.Pointer {
+		return item
+	}
+	return reflect.New(t.Field(0).Type.Elem()).Interface()
+}
+
+// applyListQuery sets the search and ordering of the list request r, and the
+// filters r supports as query parameters. Matching names is partial in the
+// API, so the results still go through filterListResults.
+func applyListQuery[R interface {
+	Search(search string) R
+	Ordering(ordering string) R
+}](r R, query listQuery) R {
+	if !query.Search.IsNull() {
+		r = r.Search(query.Search.ValueString())
+	}
+	if !query.Ordering.IsNull() {
+		r = r.Ordering(query.Ordering.ValueString())
+	}
+	filter := query.filter
+	if filter == nil {
+		return r
+	}
+	if req, ok := any(r).(interface{ Name(name string) R }); ok && !filter.Name.IsNull() {
+		r = req.Name(filter.Name.ValueString())
+	}
+	if req, ok := any(r).(interface{ Active(active bool) R }); ok && !filter.Active.IsNull() {
+		r = req.Active(filter.Active.ValueBool())
+	}
+	if !filter.Type.IsNull() {
+		switch req := any(r).(type) {
+		case interface{ TypeIn(typeIn string) R }:
+			r = req.TypeIn(filter.Type.ValueString())
+		case interface{ ListTypeIn(listTypeIn string) R }:
+			r = req.ListTypeIn(filter.Type.ValueString())
+		}
+	}
+	if req, ok := any(r).(interface {
+		LastModifiedGte(lastModifiedGte time.Time) R
+	}); ok && query.after != nil {
+		r = req.LastModifiedGte(*query.after)
+	}
+	if req, ok := any(r).(interface {
+		LastModifiedLte(lastModifiedLte time.Time) R
+	}); ok && query.before != nil {
+		r = req.LastModifiedLte(*query.before)
+	}
+	return r
+}
+
+// filterListResults keeps the results of list matching the filter of query.
+// When allPages is set, list holds every result, and its count and total
+// pages are recomputed for the results kept; otherwise they still describe the
+// unfiltered list, since only one page of it was read.
+func filterListResults[T any, P interface {
+	utils.ListPage[T]
+	GetPageSize() int64
+	SetCount(v int64)
+	SetTotalPages(v int64)
+}](list P, query listQuery, allPages bool) {
+	if query.filter == nil {
+		return
+	}
+	results := list.GetResults()
+	filtered := make([]T, 0, len(results))
+	for i := range results {
+		if query.matches(listItem(&results[i])) {
+			filtered = append(filtered, results[i])
+		}
+	}
+	list.SetResults(filtered)
+	if !allPages {
+		return
+	}
+	count := int64(len(filtered))
+	list.SetCount(count)
+	if pageSize := list.GetPageSize(); pageSize > 0 {
+		list.SetTotalPages((count + pageSize - 1) / pageSize)
+	}
+}
+
+// clearPagination sets the page and page_size arguments given to null when
+// every page was read, since they would otherwise hold the defaults of the
+// single page read without fetch_all.
+func clearPagination(allPages bool, pagination ...*types.Int64) {
+	if !allPages {
+		return
+	}
+	for _, value := range pagination {
+		*value = types.Int64Null()
+	}
+}
+
+// matches reports whether item has the fields the filter matches, with the
+// values it matches.
+func (q listQuery) matches(item any) bool {
+	filter := q.filter
+	if !filter.Name.IsNull() || q.nameRegex != nil {
+		getter, ok := item.(nameGetter)
+		if !ok {
+			return false
+		}
+		if !filter.Name.IsNull() && getter.GetName() != filter.Name.ValueString() {
+			return false
+		}
+		if q.nameRegex != nil && !q.nameRegex.MatchString(getter.GetName()) {
+			return false
+		}
+	}
+	if !filter.Active.IsNull() {
+		getter, ok := item.(activeGetter)
+		if !ok || getter.GetActive() != filter.Active.ValueBool() {
+			return false
+		}
+	}
+	if !filter.Type.IsNull() {
+		getter, ok := item.(typeGetter)
+		if !ok || getter.GetType() != filter.Type.ValueString() {
+			return false
+		}
+	}
+	if q.after != nil || q.before != nil {
+		getter, ok := item.(lastModifiedGetter)
+		if !ok {
+			return false
+		}
+		lastModified := getter.GetLastModified()
+		if q.after != nil && lastModified.Before(*q.after) {
+			return false
+		}
+		if q.before != nil && lastModified.After(*q.before) {
+			return false
+		}
+	}
+	return true
+}
+
+// idGetter is implemented by the objects listed that have a numeric ID.
+type idGetter interface{ GetId() int64 }
+
+type domainGetter interface{ GetDomain() string }
+
+// lookupIDByName returns the ID of the only object listed by fetch named
+// name, walking every page. object names the kind of object, such as
+// "connector", in the diagnostics. The API matches names partially, so fetch
+// may filter the list by name too, and the partial matches are listed as
+// candidates when no object or more than one has the exact name.
+func lookupIDByName[T any, P utils.ListPage[T]](ctx context.Context, object, name string, fetch utils.ListFetch[P], diags *diag.Diagnostics) (int64, bool) {
+	return lookupID[T](ctx, object, "name", name, func(item any) (string, bool) {
+		named, ok := item.(nameGetter)
+		if !ok {
+			return "", false
+		}
+		return named.GetName(), true
+	}, fetch, diags)
+}
+
+// lookupID returns the ID of the only object listed by fetch whose field, the
+// argument named attribute, has the given value, as read by fieldOf. It
+// reports errors on the attribute as lookupIDByName does.
+func lookupID[T any, P utils.ListPage[T]](ctx context.Context, object, attribute, value string, fieldOf func(item any) (string, bool), fetch utils.ListFetch[P], diags *diag.Diagnostics) (int64, bool) {
+	var matches, candidates []string
+	var id int64
+	for result, err := range utils.ListAll[T](ctx, fetch) {
+		if err != nil {
+			var pageErr *utils.ListPageError
+			if errors.As(err, &pageErr) && pageErr.Response != nil {
+				utils.AddAPIError(diags, err, pageErr.Response)
+			} else {
+				diags.AddError(fmt.Sprintf("Unable to list the %ss", object), err.Error())
+			}
+			return 0, false
+		}
+		item := listItem(&result)
+		field, hasField := fieldOf(item)
+		identified, hasID := item.(idGetter)
+		if !hasField || !hasID {
+			continue
+		}
+		candidate := fmt.Sprintf("%q (ID %d)", field, identified.GetId())
+		if field == value {
+			matches = append(matches, candidate)
+			id = identified.GetId()
+		} else {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return id, true
+	case 0:
+		detail := fmt.Sprintf("No %s has the %s %q.", object, attribute, value)
+		if len(candidates) > 0 {
+			detail += fmt.Sprintf(" Candidates with a similar %s: %s.", attribute, strings.Join(candidates, ", "))
+		}
+		diags.AddAttributeError(path.Root(attribute), fmt.Sprintf("No %s found", object), detail)
+	default:
+		diags.AddAttributeError(path.Root(attribute), fmt.Sprintf("Multiple %ss found", object),
+			fmt.Sprintf("%d %ss have the %s %q: %s. Use the ID to select one of them.", len(matches), object, attribute, value, strings.Join(matches, ", ")))
+	}
+	return 0, false
+}
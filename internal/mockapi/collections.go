@@ -0,0 +1,581 @@
+package mockapi
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+)
+
+// collection is a list endpoint of the API, such as /workspace/workloads, and
+// the item endpoints under it.
+type collection struct {
+	// pattern is the path of the collection, with "*" for the IDs of the
+	// objects it is nested under.
+	pattern string
+	// model returns the SDK type an object is served as.
+	model func(object map[string]any) reflect.Type
+	// listModel returns the SDK type an object is listed as, when the list
+	// endpoint serves a summary of the objects instead of the model.
+	listModel func(object map[string]any) reflect.Type
+	// key is the field identifying the objects, when they are not
+	// identified by a generated ID.
+	key string
+	// orderable collections keep an order field that can be set through
+	// their order endpoint, as the rules engines do.
+	orderable bool
+	// singleton collections hold a single object per parent, such as the
+	// DNSSEC settings of a zone, which is read and updated at the collection
+	// path and created with the defaults on first use.
+	singleton bool
+	// storeIn is set for the endpoints that create objects in another
+	// collection, such as the certificate request endpoint.
+	storeIn string
+	// nested returns the objects the API creates along with each object of
+	// the collection, keyed by the path of their collection relative to it.
+	nested func() map[string][]map[string]any
+	// fixed are fields that are set on create and kept when the object is
+	// replaced, as the update requests do not include them.
+	fixed []string
+	// defaults returns the values of the fields the API sets when they are
+	// not sent on create.
+	defaults func(id string) map[string]any
+	// derive sets the fields the API computes from the request on create,
+	// such as the CSR of a certificate signing request.
+	derive func(object map[string]any)
+	// hide removes the fields the API does not return, such as the value of
+	// a secret variable.
+	hide func(object map[string]any)
+}
+
+var collections = []*collection{
+	{
+		pattern: "/workspace/dns/zones",
+		model:   modelOf[azionapi.Zone](),
+		fixed:   []string{"domain"},
+		defaults: func(string) map[string]any {
+			return map[string]any{
+				"active":      true,
+				"nameservers": []any{"ns1.aziondns.net", "ns2.aziondns.com", "ns3.aziondns.org"},
+			}
+		},
+	},
+	{
+		pattern: "/workspace/dns/zones/*/records",
+		model:   modelOf[azionapi.Record](),
+	},
+	{
+		pattern: "/workspace/applications",
+		model:   modelOf[azionapi.Application](),
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true, "debug": false}
+		},
+		nested: func() map[string][]map[string]any {
+			return map[string][]map[string]any{
+				"request_rules": {{
+					"name":      defaultRuleName,
+					"criteria":  []any{},
+					"behaviors": []any{map[string]any{"type": "deliver"}},
+				}},
+			}
+		},
+	},
+	{
+		pattern:   "/workspace/applications/*/request_rules",
+		model:     modelOf[azionapi.RequestPhaseRule](),
+		orderable: true,
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true}
+		},
+	},
+	{
+		pattern:   "/workspace/applications/*/response_rules",
+		model:     modelOf[azionapi.ResponsePhaseRule](),
+		orderable: true,
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true}
+		},
+	},
+	{
+		pattern: "/workspace/connectors",
+		model: func(object map[string]any) reflect.Type {
+			if object["type"] == "storage" {
+				return reflect.TypeOf(azionapi.ConnectorStorage{})
+			}
+			return reflect.TypeOf(azionapi.ConnectorHTTP{})
+		},
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true}
+		},
+	},
+	{
+		pattern: "/workspace/workloads",
+		model:   modelOf[azionapi.Workload](),
+		defaults: func(id string) map[string]any {
+			return map[string]any{
+				"active":          true,
+				"infrastructure":  1,
+				"workload_domain": fmt.Sprintf("w%s.map.azionedge.net", id),
+			}
+		},
+	},
+	{
+		pattern:   "/workspace/dns/zones/*/dnssec",
+		model:     modelOf[azionapi.DNSSEC](),
+		singleton: true,
+		defaults: func(string) map[string]any {
+			return map[string]any{"enabled": false, "status": "unconfigured"}
+		},
+	},
+	{
+		pattern: "/workspace/applications/*/cache_settings",
+		model:   modelOf[azionapi.CacheSetting](),
+	},
+	{
+		pattern: "/workspace/applications/*/device_groups",
+		model:   modelOf[azionapi.DeviceGroup](),
+	},
+	{
+		pattern: "/workspace/applications/*/functions",
+		model:   modelOf[azionapi.FunctionInstance](),
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true}
+		},
+	},
+	{
+		pattern:   "/workspace/functions",
+		model:     modelOf[azionapi.Functions](),
+		listModel: modelOf[azionapi.Function](),
+		defaults: func(string) map[string]any {
+			return map[string]any{
+				"active":                true,
+				"runtime":               "azion_js",
+				"execution_environment": "application",
+				"vendor":                "azion",
+				"version":               "1.0",
+			}
+		},
+	},
+	{
+		pattern: "/workspace/firewalls",
+		model:   modelOf[azionapi.Firewall](),
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true, "debug": false}
+		},
+	},
+	{
+		pattern: "/workspace/firewalls/*/functions",
+		model:   modelOf[azionapi.FirewallFunctionInstance](),
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true}
+		},
+	},
+	{
+		pattern:   "/workspace/firewalls/*/request_rules",
+		model:     modelOf[azionapi.FirewallRule](),
+		orderable: true,
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true}
+		},
+	},
+	{
+		pattern:   "/workspace/network_lists",
+		model:     modelOf[azionapi.NetworkList](),
+		listModel: modelOf[azionapi.NetworkListSummary](),
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true}
+		},
+	},
+	{
+		pattern: "/workspace/wafs",
+		model:   modelOf[azionapi.WAF](),
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true}
+		},
+	},
+	{
+		pattern: "/workspace/wafs/*/exceptions",
+		model:   modelOf[azionapi.WAFRule](),
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true}
+		},
+	},
+	{
+		pattern: "/workspace/custom_pages",
+		model:   modelOf[azionapi.CustomPage](),
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true}
+		},
+	},
+	{
+		pattern: "/workspace/workloads/*/deployments",
+		model:   modelOf[azionapi.WorkloadDeployment](),
+		defaults: func(string) map[string]any {
+			return map[string]any{"active": true, "current": true}
+		},
+	},
+	{
+		pattern:   "/workspace/storage/buckets",
+		model:     modelOf[azionapi.BucketCreate](),
+		listModel: modelOf[azionapi.Bucket](),
+		key:       "name",
+	},
+	{
+		pattern: "/workspace/storage/credentials",
+		model:   modelOf[azionapi.Credential](),
+		defaults: func(id string) map[string]any {
+			return map[string]any{
+				"access_key": "AKMOCK" + id,
+				"secret_key": "mock-secret-" + id,
+				"buckets":    []any{},
+			}
+		},
+	},
+	{
+		pattern: "/workspace/tls/certificates",
+		model:   modelOf[azionapi.Certificate](),
+		defaults: func(string) map[string]any {
+			return map[string]any{
+				"active":        true,
+				"type":          "edge_certificate",
+				"status":        "active",
+				"authority":     "None",
+				"key_algorithm": "rsa_2048",
+			}
+		},
+	},
+	{
+		pattern: "/workspace/tls/certificates/request",
+		storeIn: "/workspace/tls/certificates",
+		model:   modelOf[azionapi.Certificate](),
+		defaults: func(string) map[string]any {
+			return map[string]any{
+				"active":        true,
+				"type":          "edge_certificate",
+				"status":        "pending",
+				"authority":     "lets_encrypt",
+				"key_algorithm": "rsa_2048",
+			}
+		},
+		derive: deriveCertificateRequest,
+	},
+	{
+		pattern: "/workspace/tls/csr",
+		storeIn: "/workspace/tls/certificates",
+		model:   modelOf[azionapi.Certificate](),
+		defaults: func(string) map[string]any {
+			return map[string]any{
+				"active":        true,
+				"type":          "edge_certificate",
+				"status":        "pending",
+				"authority":     "None",
+				"key_algorithm": "rsa_2048",
+			}
+		},
+		derive: deriveCSR,
+	},
+	{
+		pattern: "/workspace/tls/crls",
+		model:   modelOf[azionapi.CertificateRevocationList](),
+		defaults: func(string) map[string]any {
+			now := time.Now().UTC()
+			return map[string]any{
+				"active":      true,
+				"issuer":      "CN=Mock CA",
+				"last_update": now.Format(time.RFC3339),
+				"next_update": now.AddDate(0, 0, 30).Format(time.RFC3339),
+			}
+		},
+	},
+	{
+		pattern: "/workspace/variables",
+		model:   modelOf[variable](),
+		hide: func(object map[string]any) {
+			if secret, _ := object["secret"].(bool); secret {
+				delete(object, "value")
+			}
+		},
+	},
+}
+
+// variable is a variable of the account. The SDK has no variables API, so
+// the model is defined here.
+type variable struct {
+	ID           int64     `json:"id"`
+	Key          string    `json:"key"`
+	Value        string    `json:"value,omitempty"`
+	Secret       bool      `json:"secret"`
+	LastEditor   string    `json:"last_editor"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+func modelOf[T any]() func(map[string]any) reflect.Type {
+	return func(map[string]any) reflect.Type {
+		return reflect.TypeOf(*new(T))
+	}
+}
+
+// matchCollection returns the collection at path.
+func matchCollection(path string) (*collection, bool) {
+	segments := strings.Split(path, "/")
+	for _, c := range collections {
+		pattern := strings.Split(c.pattern, "/")
+		if len(pattern) != len(segments) {
+			continue
+		}
+		matched := true
+		for i, segment := range pattern {
+			if segment != "*" && segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// stamp sets the fields the API maintains on every write.
+func (c *collection) stamp(object map[string]any, created bool) {
+	fields := jsonFields(c.model(object))
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	if _, ok := fields["last_editor"]; ok {
+		object["last_editor"] = LastEditor
+	}
+	if _, ok := fields["last_modified"]; ok {
+		object["last_modified"] = now
+	}
+	if _, ok := fields["product_version"]; ok {
+		if version, _ := object["product_version"].(string); version == "" {
+			object["product_version"] = "1.0"
+		}
+	}
+	if created {
+		for _, name := range []string{"created_at", "created"} {
+			if _, ok := fields[name]; ok {
+				object[name] = now
+			}
+		}
+	}
+}
+
+// immutable returns the fields kept when an object is replaced.
+func (c *collection) immutable() []string {
+	fields := []string{"id", "created_at", "created"}
+	if c.key != "" {
+		fields = append(fields, c.key)
+	}
+	return append(fields, c.fixed...)
+}
+
+// sort sorts objects in the order the API lists them.
+func (c *collection) sort(objects []map[string]any) {
+	switch {
+	case c.orderable:
+		sortObjects(objects, "order")
+	case c.key != "":
+		sortObjects(objects, c.key)
+	default:
+		sortObjects(objects, "id")
+	}
+}
+
+// shape returns object with the fields of its SDK model.
+func (c *collection) shape(object map[string]any) any {
+	return shape(c.visible(object), c.model(object))
+}
+
+// listShape is shape for the results of the list endpoint.
+func (c *collection) listShape(object map[string]any) any {
+	if c.listModel == nil {
+		return c.shape(object)
+	}
+	return shape(c.visible(object), c.listModel(object))
+}
+
+// visible returns a copy of object without the fields the API hides.
+func (c *collection) visible(object map[string]any) map[string]any {
+	object = clone(object)
+	if c.hide != nil {
+		c.hide(object)
+	}
+	return object
+}
+
+func shapeAccount(account map[string]any) any {
+	account = clone(account)
+	account["active"] = true
+	account["status"] = "active"
+	account["reason"] = "regular"
+	account["info"] = map[string]any{}
+	return shape(account, reflect.TypeOf(azionapi.Organization{}))
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// field is a JSON field of an SDK model.
+type field struct {
+	typ      reflect.Type
+	required bool
+}
+
+// jsonFields returns the JSON fields of an SDK model, or nil for types that
+// are not plain JSON objects, such as the oneOf wrappers, which are passed
+// through as they are.
+func jsonFields(t reflect.Type) map[string]field {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType {
+		return nil
+	}
+	fields := map[string]field{}
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		fields[name] = field{typ: t.Field(i).Type, required: !strings.Contains(options, "omitempty")}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+// shape drops the fields of value that are not in the model t and fills in
+// the required fields that are missing, as the SDK rejects both.
+func shape(value any, t reflect.Type) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		fields := jsonFields(t)
+		object, ok := value.(map[string]any)
+		if fields == nil || !ok {
+			return value
+		}
+		out := map[string]any{}
+		for name, f := range fields {
+			if v, ok := object[name]; ok {
+				out[name] = shape(v, f.typ)
+			} else if f.required {
+				out[name] = zero(f.typ)
+			}
+		}
+		return out
+	case reflect.Slice:
+		items, ok := value.([]any)
+		if !ok {
+			return value
+		}
+		out := make([]any, len(items))
+		for i, item := range items {
+			out[i] = shape(item, t.Elem())
+		}
+		return out
+	}
+	return value
+}
+
+// zero returns the JSON value used for a required field that was not set.
+func zero(t reflect.Type) any {
+	if t == timeType {
+		return time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	if strings.HasPrefix(t.Name(), "Nullable") {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return nil
+	case reflect.Struct:
+		if jsonFields(t) == nil {
+			return nil
+		}
+		return shape(map[string]any{}, t)
+	case reflect.Slice:
+		return []any{}
+	case reflect.Map:
+		return map[string]any{}
+	case reflect.String:
+		return ""
+	case reflect.Bool:
+		return false
+	}
+	return 0
+}
+
+// oidEmailAddress is the subject attribute the CSR email is set in.
+var oidEmailAddress = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 1}
+
+// deriveCSR sets the PEM encoded CSR for the subject of a certificate
+// signing request, signed with a throwaway key.
+func deriveCSR(object map[string]any) {
+	text := func(field string) string {
+		value, _ := object[field].(string)
+		return value
+	}
+	list := func(field string) []string {
+		value, _ := object[field].(string)
+		if value == "" {
+			return nil
+		}
+		return []string{value}
+	}
+
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:         text("common_name"),
+			Country:            list("country"),
+			Province:           list("state"),
+			Locality:           list("locality"),
+			Organization:       list("organization"),
+			OrganizationalUnit: list("organization_unity"),
+		},
+	}
+	if email := text("email"); email != "" {
+		template.Subject.ExtraNames = []pkix.AttributeTypeAndValue{{Type: oidEmailAddress, Value: email}}
+	}
+	if names, ok := object["alternative_names"].([]any); ok {
+		for _, name := range names {
+			if name, ok := name.(string); ok {
+				template.DNSNames = append(template.DNSNames, name)
+			}
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		panic(err)
+	}
+	object["csr"] = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+	object["subject_name"] = []any{template.Subject.CommonName}
+}
+
+// deriveCertificateRequest sets the subject names of a requested certificate,
+// the common name followed by the alternative names.
+func deriveCertificateRequest(object map[string]any) {
+	subjectNames := []any{object["common_name"]}
+	if names, ok := object["alternative_names"].([]any); ok {
+		subjectNames = append(subjectNames, names...)
+	}
+	object["subject_name"] = subjectNames
+}
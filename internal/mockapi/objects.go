@@ -0,0 +1,208 @@
+package mockapi
+
+import (
+	"mime"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// bucketsPath is the collection of the storage buckets, under which the
+// objects endpoints are.
+const bucketsPath = "/workspace/storage/buckets"
+
+// storageObject is an object stored in a bucket. Objects are raw content
+// rather than JSON, so they are kept apart from the other objects.
+type storageObject struct {
+	content      []byte
+	contentType  string
+	lastModified time.Time
+}
+
+// PutObject stores an object in a bucket, as if it was uploaded outside of
+// Terraform.
+func (s *Server) PutObject(bucket, key string, content []byte, contentType string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.putObject(bucket, key, content, contentType)
+}
+
+// Object returns the content and content type of an object in a bucket.
+func (s *Server) Object(bucket, key string) ([]byte, string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	object, ok := s.storageObjects[bucket+"/"+key]
+	if !ok {
+		return nil, "", false
+	}
+	return append([]byte(nil), object.content...), object.contentType, true
+}
+
+// DeleteObject removes an object from a bucket, as if it was deleted outside
+// of Terraform.
+func (s *Server) DeleteObject(bucket, key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.storageObjects[bucket+"/"+key]; !ok {
+		return false
+	}
+	delete(s.storageObjects, bucket+"/"+key)
+	return true
+}
+
+func (s *Server) putObject(bucket, key string, content []byte, contentType string) {
+	if contentType == "" {
+		// The API detects the type of the objects uploaded without one.
+		contentType = mime.TypeByExtension(path.Ext(key))
+		if contentType == "" {
+			contentType = http.DetectContentType(content)
+		}
+	}
+	s.storageObjects[bucket+"/"+key] = storageObject{
+		content:      append([]byte(nil), content...),
+		contentType:  contentType,
+		lastModified: time.Now().UTC(),
+	}
+}
+
+// splitObjectPath splits the path of an objects endpoint, such as
+// /workspace/storage/buckets/assets/objects/css/site.css, into the bucket
+// and the object key, which is empty for the list endpoint.
+func splitObjectPath(urlPath string) (bucket, key string, ok bool) {
+	rest, ok := strings.CutPrefix(urlPath, bucketsPath+"/")
+	if !ok {
+		return "", "", false
+	}
+	bucket, rest, ok = strings.Cut(rest, "/objects")
+	if !ok || bucket == "" || strings.Contains(bucket, "/") {
+		return "", "", false
+	}
+	if rest == "" {
+		return bucket, "", true
+	}
+	key, ok = strings.CutPrefix(rest, "/")
+	return bucket, key, ok
+}
+
+// handleObjects serves the objects endpoints of the bucket. Object keys are
+// path escaped by the SDK, so they arrive whole in the decoded path.
+func (s *Server) handleObjects(w http.ResponseWriter, r *http.Request, bucket, key string, body []byte) {
+	if _, ok := s.objects[bucketsPath+"/"+bucket]; !ok {
+		writeError(w, http.StatusNotFound, "", "Bucket not found.")
+		return
+	}
+	if key == "" {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "", "Method \""+r.Method+"\" not allowed.")
+			return
+		}
+		s.listObjects(w, r, bucket)
+		return
+	}
+
+	object, exists := s.storageObjects[bucket+"/"+key]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound, "", "Object not found.")
+			return
+		}
+		w.Header().Set("Content-Type", object.contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.content)))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(object.content)
+	case http.MethodPost, http.MethodPut:
+		if r.Method == http.MethodPost && exists {
+			writeError(w, http.StatusConflict, "", "An object with this key already exists.")
+			return
+		}
+		if r.Method == http.MethodPut && !exists {
+			writeError(w, http.StatusNotFound, "", "Object not found.")
+			return
+		}
+		s.putObject(bucket, key, body, r.Header.Get("Storage-Content-Type"))
+		writeJSON(w, http.StatusOK, map[string]any{"state": "executed", "data": map[string]any{"object_key": key}})
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound, "", "Object not found.")
+			return
+		}
+		delete(s.storageObjects, bucket+"/"+key)
+		writeJSON(w, http.StatusOK, map[string]any{"state": "executed"})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "", "Method \""+r.Method+"\" not allowed.")
+	}
+}
+
+// listObjects writes the objects of the bucket under the prefix query
+// parameter, ordered by key. Unless all_levels is true, the objects in the
+// folders under the prefix are listed as a single folder entry each. Pages
+// hold max_object_count objects and are chained by continuation tokens.
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request, bucket string) {
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	allLevels := query.Get("all_levels") == "true"
+	maxCount := 1000
+	if v, err := strconv.Atoi(query.Get("max_object_count")); err == nil && v > 0 {
+		maxCount = v
+	}
+	start := 0
+	if token := query.Get("continuation_token"); token != "" {
+		v, err := strconv.Atoi(token)
+		if err != nil || v < 0 {
+			writeError(w, http.StatusBadRequest, "continuation_token", "Invalid continuation token.")
+			return
+		}
+		start = v
+	}
+
+	var entries []map[string]any
+	folders := map[string]bool{}
+	var keys []string
+	for storedKey := range s.storageObjects {
+		if key, ok := strings.CutPrefix(storedKey, bucket+"/"); ok && strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		object := s.storageObjects[bucket+"/"+key]
+		if i := strings.Index(key[len(prefix):], "/"); i >= 0 && !allLevels {
+			folder := key[:len(prefix)+i+1]
+			if !folders[folder] {
+				folders[folder] = true
+				entries = append(entries, map[string]any{
+					"key":           folder,
+					"last_modified": object.lastModified.Format(time.RFC3339Nano),
+					"size":          0,
+					"is_folder":     true,
+				})
+			}
+			continue
+		}
+		entries = append(entries, map[string]any{
+			"key":           key,
+			"last_modified": object.lastModified.Format(time.RFC3339Nano),
+			"size":          len(object.content),
+			"is_folder":     false,
+		})
+	}
+
+	start = min(start, len(entries))
+	end := min(start+maxCount, len(entries))
+	var continuationToken any
+	if end < len(entries) {
+		continuationToken = strconv.Itoa(end)
+	}
+	results := entries[start:end]
+	if results == nil {
+		results = []map[string]any{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"continuation_token": continuationToken,
+		"results":            results,
+	})
+}
@@ -0,0 +1,70 @@
+package mockapi
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// purgePath is the prefix of the purge endpoints, which take the type of
+// purge as the last segment.
+const purgePath = "/workspace/purge/"
+
+// Purge is a purge request accepted by the server.
+type Purge struct {
+	Type  string
+	Items []string
+	Layer string
+}
+
+// Purges returns the purge requests accepted by the server, in order.
+func (s *Server) Purges() []Purge {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.purges)
+}
+
+// handlePurge serves the purge endpoints, which validate and record the
+// request and answer with the purge as queued.
+func (s *Server) handlePurge(w http.ResponseWriter, r *http.Request, purgeType string, object map[string]any) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "", "Method not allowed.")
+		return
+	}
+	if !slices.Contains([]string{"url", "cachekey", "wildcard"}, purgeType) {
+		writeError(w, http.StatusNotFound, "", "Not found.")
+		return
+	}
+
+	raw, _ := object["items"].([]any)
+	if len(raw) == 0 {
+		writeError(w, http.StatusBadRequest, "items", "This field is required.")
+		return
+	}
+	items := make([]string, len(raw))
+	for i, item := range raw {
+		items[i], _ = item.(string)
+		if items[i] == "" {
+			writeError(w, http.StatusBadRequest, "items", "Items may not be blank.")
+			return
+		}
+		if purgeType == "wildcard" && !strings.Contains(items[i], "*") {
+			writeError(w, http.StatusBadRequest, "items", "Wildcard purge items must contain a wildcard.")
+			return
+		}
+	}
+	layer, _ := object["layer"].(string)
+	if layer == "" {
+		layer = "cache"
+	}
+	if layer != "cache" && layer != "tiered_cache" {
+		writeError(w, http.StatusBadRequest, "layer", `"`+layer+`" is not a valid choice.`)
+		return
+	}
+
+	s.purges = append(s.purges, Purge{Type: purgeType, Items: items, Layer: layer})
+	writeJSON(w, http.StatusAccepted, map[string]any{
+		"state": "pending",
+		"data":  map[string]any{"items": items, "layer": layer},
+	})
+}
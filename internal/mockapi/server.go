@@ -0,0 +1,632 @@
+// Package mockapi is an in-memory fake of the Azion v4 API used by the
+// provider's acceptance tests, so that they can run without network access
+// or credentials.
+//
+// The server keeps the objects created through it and serves them back with
+// the shape of the SDK response models: fields the API does not return are
+// dropped, and required fields computed by the API, such as last_editor or
+// last_modified, are filled in. Faults such as 429 and 500 responses can be
+// injected to exercise the retry and error handling paths.
+package mockapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Token is the API token accepted by the server. It is 40 characters long, as
+// the provider requires.
+const Token = "mockapitoken0000000000000000000000000000"
+
+// defaultRuleName is the name of the rule the API creates with each
+// application, which is kept first in the request phase.
+const defaultRuleName = "Default Rule"
+
+// LastEditor is the last_editor reported for the objects written through the
+// server.
+const LastEditor = "mock@azion.com"
+
+// Fault makes the server fail the requests that match it.
+type Fault struct {
+	// Method matches the request method. Empty matches any method.
+	Method string
+	// Path matches requests whose path starts with it. Empty matches any
+	// path.
+	Path string
+	// Status is the HTTP status code returned.
+	Status int
+	// Times is the number of requests that fail. Zero or less fails every
+	// matching request.
+	Times int
+	// RetryAfter is sent as the Retry-After header when set.
+	RetryAfter string
+	// Drop closes the connection without a response, as a network failure
+	// does, instead of answering with Status.
+	Drop bool
+}
+
+// Request is a request received by the server.
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a fake Azion v4 API. The zero value is not usable; create one
+// with NewServer.
+type Server struct {
+	// URL is the base URL of the API, to be set as the provider's api_url.
+	URL string
+
+	server *httptest.Server
+
+	mu             sync.Mutex
+	objects        map[string]map[string]any
+	storageObjects map[string]storageObject
+	nextID         int64
+	faults         []*Fault
+	requests       []Request
+	purges         []Purge
+	account        map[string]any
+}
+
+// NewServer starts a server. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		objects:        map[string]map[string]any{},
+		storageObjects: map[string]storageObject{},
+		nextID:         1000,
+		account: map[string]any{
+			"id":   int64(1),
+			"name": "Mock Account",
+			"type": "Organization",
+		},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// SetAccount replaces the account returned for the API token.
+func (s *Server) SetAccount(id int64, name, clientType string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.account = map[string]any{"id": id, "name": name, "type": clientType}
+}
+
+// InjectFault adds a fault. Faults are checked in the order they were added.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes the faults that are still active.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Create adds an object to the collection at collectionPath, such as
+// "/workspace/workloads", as if it was created through the API, and returns
+// the stored object.
+func (s *Server) Create(collectionPath string, object map[string]any) (map[string]any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := matchCollection(collectionPath)
+	if !ok {
+		return nil, fmt.Errorf("no collection at %s", collectionPath)
+	}
+	stored, fail := s.create(c, collectionPath, clone(object))
+	if fail != nil {
+		return nil, fmt.Errorf("mock API returned %d: %s", fail.status, fail.detail)
+	}
+	return clone(stored), nil
+}
+
+// Get returns the object at itemPath, such as "/workspace/workloads/1000".
+func (s *Server) Get(itemPath string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	object, ok := s.objects[itemPath]
+	return clone(object), ok
+}
+
+// Patch changes fields of the object at itemPath, as if it was changed
+// outside of Terraform.
+func (s *Server) Patch(itemPath string, fields map[string]any) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	object, ok := s.objects[itemPath]
+	if !ok {
+		return false
+	}
+	merge(object, clone(fields))
+	return true
+}
+
+// Delete removes the object at itemPath and the objects nested under it, as
+// if it was deleted outside of Terraform.
+func (s *Server) Delete(itemPath string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.delete(itemPath)
+}
+
+func (s *Server) delete(itemPath string) bool {
+	if _, ok := s.objects[itemPath]; !ok {
+		return false
+	}
+	for key := range s.objects {
+		if key == itemPath || strings.HasPrefix(key, itemPath+"/") {
+			delete(s.objects, key)
+		}
+	}
+	return true
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery, Header: r.Header.Clone(), Body: body})
+
+	if r.Header.Get("Authorization") != "token "+Token {
+		writeError(w, http.StatusUnauthorized, "", "Invalid token.")
+		return
+	}
+	if s.fault(w, r) {
+		return
+	}
+
+	if r.URL.Path == "/account/account" && r.Method == http.MethodGet {
+		writeJSON(w, http.StatusOK, map[string]any{"data": shapeAccount(s.account)})
+		return
+	}
+
+	if bucket, key, ok := splitObjectPath(r.URL.Path); ok {
+		s.handleObjects(w, r, bucket, key, body)
+		return
+	}
+
+	var object map[string]any
+	if len(body) > 0 && (r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch) {
+		if err := json.Unmarshal(body, &object); err != nil {
+			writeError(w, http.StatusBadRequest, "", "JSON parse error - "+err.Error())
+			return
+		}
+	}
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	if purgeType, ok := strings.CutPrefix(path, purgePath); ok {
+		s.handlePurge(w, r, purgeType, object)
+		return
+	}
+	if c, ok := matchCollection(path); ok {
+		if c.singleton {
+			s.handleSingleton(w, r, c, path, object)
+			return
+		}
+		s.handleCollection(w, r, c, path, object)
+		return
+	}
+	if collectionPath, id := splitLast(path); collectionPath != "" {
+		if c, ok := matchCollection(collectionPath); ok {
+			s.handleItem(w, r, c, collectionPath, id, object)
+			return
+		}
+	}
+	writeError(w, http.StatusNotImplemented, "", fmt.Sprintf("%s %s is not implemented by the mock API.", r.Method, r.URL.Path))
+}
+
+// fault writes the response of the first fault matching r, if any.
+func (s *Server) fault(w http.ResponseWriter, r *http.Request) bool {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		if f.Drop {
+			if conn, _, err := http.NewResponseController(w).Hijack(); err == nil {
+				conn.Close()
+				return true
+			}
+		}
+		if f.RetryAfter != "" {
+			w.Header().Set("Retry-After", f.RetryAfter)
+		}
+		writeError(w, f.Status, "", "Fault injected by the mock API.")
+		return true
+	}
+	return false
+}
+
+func (s *Server) handleCollection(w http.ResponseWriter, r *http.Request, c *collection, collectionPath string, object map[string]any) {
+	if !s.parentExists(collectionPath) {
+		writeError(w, http.StatusNotFound, "", "Not found.")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		s.list(w, r, c, collectionPath)
+	case http.MethodPost:
+		storePath := collectionPath
+		if c.storeIn != "" {
+			storePath = c.storeIn
+		}
+		stored, fail := s.create(c, storePath, object)
+		if fail != nil {
+			writeError(w, fail.status, fail.field, fail.detail)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"state": "executed", "data": c.shape(stored)})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "", fmt.Sprintf("Method %q not allowed.", r.Method))
+	}
+}
+
+func (s *Server) handleItem(w http.ResponseWriter, r *http.Request, c *collection, collectionPath, id string, object map[string]any) {
+	if id == "order" && c.orderable && r.Method == http.MethodPut {
+		s.reorder(w, r, c, collectionPath, object)
+		return
+	}
+
+	itemPath := collectionPath + "/" + id
+	stored, ok := s.objects[itemPath]
+	if !ok {
+		writeError(w, http.StatusNotFound, "", "Not found.")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]any{"data": c.shape(stored)})
+	case http.MethodPatch, http.MethodPut:
+		if r.Method == http.MethodPatch {
+			merge(stored, object)
+		} else {
+			replaced := object
+			if replaced == nil {
+				replaced = map[string]any{}
+			}
+			for _, field := range c.immutable() {
+				if value, ok := stored[field]; ok {
+					replaced[field] = value
+				}
+			}
+			if _, ok := replaced["order"]; !ok && c.orderable {
+				replaced["order"] = stored["order"]
+			}
+			stored = replaced
+			s.objects[itemPath] = stored
+		}
+		c.stamp(stored, false)
+		writeJSON(w, http.StatusOK, map[string]any{"state": "executed", "data": c.shape(stored)})
+	case http.MethodDelete:
+		s.delete(itemPath)
+		writeJSON(w, http.StatusOK, map[string]any{"state": "executed"})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "", fmt.Sprintf("Method %q not allowed.", r.Method))
+	}
+}
+
+func (s *Server) handleSingleton(w http.ResponseWriter, r *http.Request, c *collection, itemPath string, object map[string]any) {
+	if !s.parentExists(itemPath) {
+		writeError(w, http.StatusNotFound, "", "Not found.")
+		return
+	}
+	stored, ok := s.objects[itemPath]
+	if !ok {
+		stored = c.defaults("")
+		c.stamp(stored, true)
+		s.objects[itemPath] = stored
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]any{"data": c.shape(stored)})
+	case http.MethodPatch, http.MethodPut:
+		merge(stored, object)
+		c.stamp(stored, false)
+		writeJSON(w, http.StatusOK, map[string]any{"state": "executed", "data": c.shape(stored)})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "", fmt.Sprintf("Method %q not allowed.", r.Method))
+	}
+}
+
+// failure is an error response of the API.
+type failure struct {
+	status int
+	field  string
+	detail string
+}
+
+func (s *Server) create(c *collection, collectionPath string, object map[string]any) (map[string]any, *failure) {
+	if object == nil {
+		object = map[string]any{}
+	}
+
+	var id string
+	if c.key != "" {
+		key, _ := object[c.key].(string)
+		if key == "" {
+			return nil, &failure{http.StatusBadRequest, c.key, "This field is required."}
+		}
+		if _, exists := s.objects[collectionPath+"/"+key]; exists {
+			return nil, &failure{http.StatusBadRequest, c.key, fmt.Sprintf("An object with this %s already exists.", c.key)}
+		}
+		id = key
+	} else {
+		s.nextID++
+		object["id"] = s.nextID
+		id = strconv.FormatInt(s.nextID, 10)
+	}
+
+	if c.orderable {
+		if _, ok := object["order"]; !ok {
+			object["order"] = int64(len(s.children(collectionPath)) + 1)
+		}
+	}
+	// Read-only fields sent empty, as the SDK does for required ones, are
+	// set by the API like missing ones.
+	if c.defaults != nil {
+		for field, value := range c.defaults(id) {
+			if current, ok := object[field]; !ok || current == nil || current == "" {
+				object[field] = value
+			}
+		}
+	}
+	if c.derive != nil {
+		c.derive(object)
+	}
+	c.stamp(object, true)
+
+	itemPath := collectionPath + "/" + id
+	s.objects[itemPath] = object
+	if c.nested != nil {
+		for relativePath, objects := range c.nested() {
+			nestedPath := itemPath + "/" + relativePath
+			nestedCollection, _ := matchCollection(nestedPath)
+			for _, nestedObject := range objects {
+				s.create(nestedCollection, nestedPath, nestedObject)
+			}
+		}
+	}
+	return object, nil
+}
+
+// list writes a page of the collection, using the page and page_size query
+// parameters of the v4 API.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, c *collection, collectionPath string) {
+	page, pageSize := 1, 10
+	query := r.URL.Query()
+	if v, err := strconv.Atoi(query.Get("page")); err == nil && v > 0 {
+		page = v
+	}
+	if v, err := strconv.Atoi(query.Get("page_size")); err == nil && v > 0 {
+		pageSize = v
+	}
+
+	children := s.children(collectionPath)
+	c.sort(children)
+	// The API matches names and domains partially and regardless of case.
+	for _, field := range []string{"name", "domain"} {
+		if value := strings.ToLower(query.Get(field)); value != "" {
+			children = slices.DeleteFunc(children, func(object map[string]any) bool {
+				objectValue, _ := object[field].(string)
+				return !strings.Contains(strings.ToLower(objectValue), value)
+			})
+		}
+	}
+
+	count := len(children)
+	totalPages := (count + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	start := (page - 1) * pageSize
+	if start > count {
+		start = count
+	}
+	end := start + pageSize
+	if end > count {
+		end = count
+	}
+
+	results := make([]any, 0, end-start)
+	for _, object := range children[start:end] {
+		results = append(results, c.listShape(object))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"count":       count,
+		"total_pages": totalPages,
+		"page":        page,
+		"page_size":   pageSize,
+		"results":     results,
+	})
+}
+
+// reorder handles the order endpoints of the rules engines, which take the
+// IDs of every rule in the new order.
+func (s *Server) reorder(w http.ResponseWriter, r *http.Request, c *collection, collectionPath string, object map[string]any) {
+	ids, _ := object["order"].([]any)
+	var pinned []map[string]any
+	for _, child := range s.children(collectionPath) {
+		if child["name"] == defaultRuleName {
+			pinned = append(pinned, child)
+		}
+	}
+	// The Default Rule stays first, whether or not it is listed.
+	listed := map[string]bool{}
+	for _, id := range ids {
+		listed[idString(id)] = true
+	}
+	for _, rule := range pinned {
+		if !listed[idString(rule["id"])] {
+			ids = append([]any{rule["id"]}, ids...)
+		}
+	}
+	if len(ids) != len(s.children(collectionPath)) {
+		writeError(w, http.StatusBadRequest, "order", "The order must contain the IDs of all the rules.")
+		return
+	}
+	for i, id := range ids {
+		stored, ok := s.objects[collectionPath+"/"+idString(id)]
+		if !ok {
+			writeError(w, http.StatusBadRequest, "order", fmt.Sprintf("Rule %v does not exist.", id))
+			return
+		}
+		stored["order"] = int64(i + 1)
+		c.stamp(stored, false)
+	}
+	s.list(w, r, c, collectionPath)
+}
+
+// children returns the objects directly under collectionPath.
+func (s *Server) children(collectionPath string) []map[string]any {
+	var children []map[string]any
+	for key, object := range s.objects {
+		rest, ok := strings.CutPrefix(key, collectionPath+"/")
+		if ok && !strings.Contains(rest, "/") {
+			children = append(children, object)
+		}
+	}
+	return children
+}
+
+// parentExists reports whether the object a nested collection belongs to,
+// such as the zone of a records collection, exists.
+func (s *Server) parentExists(collectionPath string) bool {
+	parent, _ := splitLast(collectionPath)
+	parentCollection, _ := splitLast(parent)
+	if _, ok := matchCollection(parentCollection); !ok {
+		return true
+	}
+	_, ok := s.objects[parent]
+	return ok
+}
+
+// splitLast splits a path before its last segment.
+func splitLast(path string) (string, string) {
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return "", path
+	}
+	return path[:i], path[i+1:]
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-Id", "mock-"+strconv.FormatInt(time.Now().UnixNano(), 36))
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes a v4 error envelope, pointing at field when it is set.
+func writeError(w http.ResponseWriter, status int, field, detail string) {
+	apiErr := map[string]any{
+		"status": strconv.Itoa(status),
+		"title":  http.StatusText(status),
+		"detail": detail,
+	}
+	if field != "" {
+		apiErr["source"] = map[string]any{"pointer": "/" + field}
+	}
+	writeJSON(w, status, map[string]any{"errors": []any{apiErr}})
+}
+
+// merge applies a JSON merge patch: objects are merged recursively and any
+// other value replaces the existing one.
+func merge(dst, patch map[string]any) {
+	for key, value := range patch {
+		if patchObject, ok := value.(map[string]any); ok {
+			if dstObject, ok := dst[key].(map[string]any); ok {
+				merge(dstObject, patchObject)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+// clone returns a deep copy of a decoded JSON object.
+func clone(object map[string]any) map[string]any {
+	if object == nil {
+		return nil
+	}
+	data, _ := json.Marshal(object)
+	var out map[string]any
+	_ = json.Unmarshal(data, &out)
+	return out
+}
+
+// sortObjects sorts objects by the numeric or string value of field.
+func sortObjects(objects []map[string]any, field string) {
+	sort.SliceStable(objects, func(i, j int) bool {
+		a, b := objects[i][field], objects[j][field]
+		if af, ok := number(a); ok {
+			if bf, ok := number(b); ok {
+				return af < bf
+			}
+		}
+		return fmt.Sprint(a) < fmt.Sprint(b)
+	})
+}
+
+// idString formats an ID decoded from JSON.
+func idString(id any) string {
+	if n, ok := number(id); ok {
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	}
+	return fmt.Sprint(id)
+}
+
+func number(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
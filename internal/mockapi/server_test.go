@@ -0,0 +1,344 @@
+package mockapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+)
+
+func newTestClient(t *testing.T, token string) (*Server, *azionapi.APIClient) {
+	t.Helper()
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	config := azionapi.NewConfiguration()
+	config.Servers[0].URL = server.URL
+	config.AddDefaultHeader("Authorization", "token "+token)
+	return server, azionapi.NewAPIClient(config)
+}
+
+func TestServerZonesAndRecords(t *testing.T) {
+	server, client := newTestClient(t, Token)
+	ctx := context.Background()
+
+	zone, _, err := client.DNSZonesAPI.CreateDnsZone(ctx).ZoneRequest(*azionapi.NewZoneRequest("example", "example.com", true)).Execute()
+	if err != nil {
+		t.Fatalf("CreateDnsZone: %v", err)
+	}
+	if zone.Data.Domain != "example.com" || len(zone.Data.Nameservers) == 0 {
+		t.Errorf("zone = %+v, want the domain and nameservers set", zone.Data)
+	}
+
+	record, _, err := client.DNSRecordsAPI.CreateDnsRecord(ctx, zone.Data.Id).RecordRequest(*azionapi.NewRecordRequest("www", "A", []string{"192.0.2.1"})).Execute()
+	if err != nil {
+		t.Fatalf("CreateDnsRecord: %v", err)
+	}
+
+	patch := azionapi.PatchedRecordRequest{}
+	patch.SetRdata([]string{"192.0.2.2"})
+	updated, _, err := client.DNSRecordsAPI.PartialUpdateDnsRecord(ctx, record.Data.Id, zone.Data.Id).PatchedRecordRequest(patch).Execute()
+	if err != nil {
+		t.Fatalf("PartialUpdateDnsRecord: %v", err)
+	}
+	if updated.Data.Name != "www" || updated.Data.Rdata[0] != "192.0.2.2" {
+		t.Errorf("record = %+v, want name www and the new rdata", updated.Data)
+	}
+
+	records, _, err := client.DNSRecordsAPI.ListDnsRecords(ctx, zone.Data.Id).Execute()
+	if err != nil {
+		t.Fatalf("ListDnsRecords: %v", err)
+	}
+	if records.GetCount() != 1 {
+		t.Errorf("count = %d, want 1", records.GetCount())
+	}
+
+	if !server.Delete("/workspace/dns/zones/" + idString(zone.Data.Id)) {
+		t.Fatal("Delete did not find the zone")
+	}
+	_, response, err := client.DNSRecordsAPI.RetrieveDnsRecord(ctx, record.Data.Id, zone.Data.Id).Execute()
+	if err == nil || response.StatusCode != http.StatusNotFound {
+		t.Errorf("RetrieveDnsRecord after deleting the zone = %v, want a 404", err)
+	}
+}
+
+func TestServerWorkloads(t *testing.T) {
+	_, client := newTestClient(t, Token)
+	ctx := context.Background()
+
+	created, _, err := client.WorkloadsAPI.CreateWorkload(ctx).WorkloadRequest(*azionapi.NewWorkloadRequest("shop")).Execute()
+	if err != nil {
+		t.Fatalf("CreateWorkload: %v", err)
+	}
+	if created.Data.WorkloadDomain == "" || created.Data.LastEditor != LastEditor {
+		t.Errorf("workload = %+v, want the computed fields set", created.Data)
+	}
+
+	patch := azionapi.PatchedWorkloadRequest{}
+	patch.SetName("shop-v2")
+	updated, _, err := client.WorkloadsAPI.PartialUpdateWorkload(ctx, created.Data.Id).PatchedWorkloadRequest(patch).Execute()
+	if err != nil {
+		t.Fatalf("PartialUpdateWorkload: %v", err)
+	}
+	if updated.Data.Name != "shop-v2" || updated.Data.WorkloadDomain != created.Data.WorkloadDomain {
+		t.Errorf("workload = %+v, want the new name and the same domain", updated.Data)
+	}
+
+	if _, _, err := client.WorkloadsAPI.DeleteWorkload(ctx, created.Data.Id).Execute(); err != nil {
+		t.Fatalf("DeleteWorkload: %v", err)
+	}
+	_, response, err := client.WorkloadsAPI.RetrieveWorkload(ctx, created.Data.Id).Execute()
+	if err == nil || response.StatusCode != http.StatusNotFound {
+		t.Errorf("RetrieveWorkload after delete = %v, want a 404", err)
+	}
+}
+
+func TestServerConnectorsAndBuckets(t *testing.T) {
+	_, client := newTestClient(t, Token)
+	ctx := context.Background()
+
+	bucket, _, err := client.StorageBucketsAPI.CreateBucket(ctx).BucketCreateRequest(*azionapi.NewBucketCreateRequest("assets", "read_only")).Execute()
+	if err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if _, _, err := client.StorageBucketsAPI.CreateBucket(ctx).BucketCreateRequest(*azionapi.NewBucketCreateRequest("assets", "read_only")).Execute(); err == nil {
+		t.Error("CreateBucket with a duplicated name succeeded")
+	}
+
+	attributes := azionapi.ConnectorStorageAttributesRequest{Bucket: bucket.Data.Name}
+	request := azionapi.ConnectorStorageRequestAsConnectorRequest(azionapi.NewConnectorStorageRequest("assets", "storage", attributes))
+	connector, _, err := client.ConnectorsAPI.CreateConnector(ctx).ConnectorRequest(request).Execute()
+	if err != nil {
+		t.Fatalf("CreateConnector: %v", err)
+	}
+	storage := connector.Data.ConnectorStorage
+	if storage == nil || storage.Attributes.Bucket != "assets" {
+		t.Fatalf("connector = %+v, want a storage connector for the bucket", connector.Data)
+	}
+
+	retrieved, _, err := client.StorageBucketsAPI.RetrieveBucket(ctx, "assets").Execute()
+	if err != nil {
+		t.Fatalf("RetrieveBucket: %v", err)
+	}
+	if retrieved.Data.WorkloadsAccess != "read_only" {
+		t.Errorf("workloads_access = %q, want read_only", retrieved.Data.WorkloadsAccess)
+	}
+}
+
+func TestServerStorageObjects(t *testing.T) {
+	server, client := newTestClient(t, Token)
+	ctx := context.Background()
+
+	upload := func(key, content string) *os.File {
+		t.Helper()
+		file, err := os.CreateTemp(t.TempDir(), "object")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := file.WriteString(content); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	if _, response, err := client.StorageObjectsAPI.CreateObjectKey(ctx, "assets", "index.html").Body(upload("index.html", "<html></html>")).Execute(); err == nil || response.StatusCode != http.StatusNotFound {
+		t.Fatalf("CreateObjectKey without the bucket = %v, want a 404", err)
+	}
+	if _, _, err := client.StorageBucketsAPI.CreateBucket(ctx).BucketCreateRequest(*azionapi.NewBucketCreateRequest("assets", "read_only")).Execute(); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	for key, content := range map[string]string{"index.html": "<html></html>", "css/site.css": "body {}", "css/print.css": "@media print {}"} {
+		if _, _, err := client.StorageObjectsAPI.CreateObjectKey(ctx, "assets", key).Body(upload(key, content)).Execute(); err != nil {
+			t.Fatalf("CreateObjectKey(%s): %v", key, err)
+		}
+	}
+	if _, _, err := client.StorageObjectsAPI.UpdateObjectKey(ctx, "assets", "css/site.css").StorageContentType("text/plain").Body(upload("css/site.css", "p {}")).Execute(); err != nil {
+		t.Fatalf("UpdateObjectKey: %v", err)
+	}
+	if content, contentType, _ := server.Object("assets", "css/site.css"); string(content) != "p {}" || contentType != "text/plain" {
+		t.Errorf("object = %q %q, want the update", content, contentType)
+	}
+
+	file, response, err := client.StorageObjectsAPI.DownloadObject(ctx, "assets", "index.html").Execute()
+	if err != nil {
+		t.Fatalf("DownloadObject: %v", err)
+	}
+	defer os.Remove(file.Name())
+	if content, _ := io.ReadAll(file); string(content) != "<html></html>" || !strings.HasPrefix(response.Header.Get("Content-Type"), "text/html") {
+		t.Errorf("downloaded %q as %q", content, response.Header.Get("Content-Type"))
+	}
+
+	top, _, err := client.StorageObjectsAPI.ListObjects(ctx, "assets").Execute()
+	if err != nil {
+		t.Fatalf("ListObjects: %v", err)
+	}
+	if len(top.Results) != 2 || top.Results[0].Key != "css/" || !top.Results[0].IsFolder {
+		t.Errorf("top level = %+v, want the css/ folder and index.html", top.Results)
+	}
+	first, _, err := client.StorageObjectsAPI.ListObjects(ctx, "assets").Prefix("css/").MaxObjectCount(1).Execute()
+	if err != nil {
+		t.Fatalf("ListObjects: %v", err)
+	}
+	second, _, err := client.StorageObjectsAPI.ListObjects(ctx, "assets").Prefix("css/").ContinuationToken(*first.ContinuationToken.Get()).Execute()
+	if err != nil {
+		t.Fatalf("ListObjects: %v", err)
+	}
+	if first.Results[0].Key != "css/print.css" || len(second.Results) != 1 || second.Results[0].Key != "css/site.css" || second.ContinuationToken.Get() != nil {
+		t.Errorf("pages = %+v, %+v, want the css objects one at a time", first.Results, second.Results)
+	}
+
+	if _, _, err := client.StorageObjectsAPI.DeleteObjectKey(ctx, "assets", "index.html").Execute(); err != nil {
+		t.Fatalf("DeleteObjectKey: %v", err)
+	}
+	if _, response, err := client.StorageObjectsAPI.DownloadObject(ctx, "assets", "index.html").Execute(); err == nil || response.StatusCode != http.StatusNotFound {
+		t.Errorf("DownloadObject after delete = %v, want a 404", err)
+	}
+}
+
+func TestServerApplicationsAndRules(t *testing.T) {
+	_, client := newTestClient(t, Token)
+	ctx := context.Background()
+
+	application, _, err := client.ApplicationsAPI.CreateApplication(ctx).ApplicationRequest(*azionapi.NewApplicationRequest("site")).Execute()
+	if err != nil {
+		t.Fatalf("CreateApplication: %v", err)
+	}
+
+	var ids []int64
+	for _, name := range []string{"first", "second"} {
+		criteria := [][]azionapi.ApplicationCriterionFieldRequest{{*azionapi.NewApplicationCriterionFieldRequest("if", "${uri}", "exists")}}
+		behaviors := []azionapi.RequestPhaseBehaviorRequest{{BehaviorNoArgs: azionapi.NewBehaviorNoArgs("deny")}}
+		rule, _, err := client.ApplicationsRequestRulesAPI.CreateApplicationRequestRule(ctx, application.Data.Id).
+			RequestPhaseRuleRequest(*azionapi.NewRequestPhaseRuleRequest(name, criteria, behaviors)).Execute()
+		if err != nil {
+			t.Fatalf("CreateApplicationRequestRule: %v", err)
+		}
+		ids = append(ids, rule.Data.Id)
+	}
+
+	order := azionapi.NewApplicationRequestPhaseRuleEngineOrder([]int64{ids[1], ids[0]})
+	rules, _, err := client.ApplicationsRequestRulesAPI.UpdateApplicationRequestRulesOrder(ctx, application.Data.Id).
+		ApplicationRequestPhaseRuleEngineOrder(*order).Execute()
+	if err != nil {
+		t.Fatalf("UpdateApplicationRequestRulesOrder: %v", err)
+	}
+	got := rules.Results
+	if len(got) != 3 || got[0].Name != defaultRuleName || got[1].Name != "second" || got[1].Order != 2 {
+		t.Errorf("rules = %+v, want the Default Rule and then second", got)
+	}
+}
+
+func TestServerCertificates(t *testing.T) {
+	_, client := newTestClient(t, Token)
+	ctx := context.Background()
+
+	certificate := azionapi.Certificate{Name: "example"}
+	certificate.SetCertificate("-----BEGIN CERTIFICATE-----")
+	created, _, err := client.DigitalCertificatesCertificatesAPI.CreateCertificate(ctx).Certificate(certificate).Execute()
+	if err != nil {
+		t.Fatalf("CreateCertificate: %v", err)
+	}
+	if created.Data.Status != "active" || created.Data.Name != "example" {
+		t.Errorf("certificate = %+v, want an active certificate named example", created.Data)
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	server, client := newTestClient(t, Token)
+	ctx := context.Background()
+
+	server.InjectFault(Fault{Method: http.MethodGet, Path: "/workspace/workloads", Status: http.StatusTooManyRequests, Times: 2, RetryAfter: "1"})
+	for i := 0; i < 2; i++ {
+		_, response, err := client.WorkloadsAPI.ListWorkloads(ctx).Execute()
+		if err == nil || response.StatusCode != http.StatusTooManyRequests || response.Header.Get("Retry-After") != "1" {
+			t.Fatalf("request %d = %v, want a 429 with Retry-After", i, err)
+		}
+	}
+	if _, _, err := client.WorkloadsAPI.ListWorkloads(ctx).Execute(); err != nil {
+		t.Fatalf("ListWorkloads after the fault ran out: %v", err)
+	}
+
+	server.InjectFault(Fault{Status: http.StatusInternalServerError})
+	if _, response, err := client.DNSZonesAPI.ListDnsZones(ctx).Execute(); err == nil || response.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("ListDnsZones = %v, want a 500", err)
+	}
+	server.ClearFaults()
+	if _, _, err := client.DNSZonesAPI.ListDnsZones(ctx).Execute(); err != nil {
+		t.Fatalf("ListDnsZones after ClearFaults: %v", err)
+	}
+
+	if got := len(server.Requests()); got != 5 {
+		t.Errorf("recorded %d requests, want 5", got)
+	}
+	server.InjectFault(Fault{Drop: true})
+	if _, response, err := client.DNSZonesAPI.ListDnsZones(ctx).Execute(); err == nil || response != nil {
+		t.Fatalf("ListDnsZones = %v, want no response", err)
+	}
+	server.ClearFaults()
+}
+
+func TestServerAuthentication(t *testing.T) {
+	_, client := newTestClient(t, "wrong")
+	_, response, err := client.AccountsLoggedInAPI.RetrieveLoggedAccountDetails(context.Background()).Execute()
+	if err == nil || response.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("RetrieveLoggedAccountDetails = %v, want a 401", err)
+	}
+
+	_, client = newTestClient(t, Token)
+	account, _, err := client.AccountsLoggedInAPI.RetrieveLoggedAccountDetails(context.Background()).Execute()
+	if err != nil {
+		t.Fatalf("RetrieveLoggedAccountDetails: %v", err)
+	}
+	if account.Data.Organization == nil || account.Data.Organization.Name != "Mock Account" {
+		t.Errorf("account = %+v, want the mock organization", account.Data)
+	}
+}
+
+func TestServerVariables(t *testing.T) {
+	server, _ := newTestClient(t, Token)
+	secret, err := server.Create("/workspace/variables", map[string]any{"key": "API_TOKEN", "value": "s3cr3t", "secret": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/workspace/variables/"+idString(secret["id"]), nil)
+	req.Header.Set("Authorization", "token "+Token)
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusOK || strings.Contains(string(body), "s3cr3t") || !strings.Contains(string(body), `"key":"API_TOKEN"`) {
+		t.Errorf("GET secret variable = %d %s, want it without its value", response.StatusCode, body)
+	}
+}
+
+func TestServerPurge(t *testing.T) {
+	server, client := newTestClient(t, Token)
+	ctx := context.Background()
+
+	request := azionapi.NewPurgeRequest([]string{"www.example.com/images/*"})
+	request.SetLayer("tiered_cache")
+	if _, _, err := client.PurgeAPI.CreatePurgeRequest(ctx, "wildcard").PurgeRequest(*request).Execute(); err != nil {
+		t.Fatalf("CreatePurgeRequest: %v", err)
+	}
+	if _, response, err := client.PurgeAPI.CreatePurgeRequest(ctx, "wildcard").PurgeRequest(*azionapi.NewPurgeRequest([]string{"www.example.com/"})).Execute(); err == nil || response.StatusCode != http.StatusBadRequest {
+		t.Fatalf("CreatePurgeRequest without a wildcard = %v, want a 400", err)
+	}
+	if _, _, err := client.PurgeAPI.CreatePurgeRequest(ctx, "url").PurgeRequest(*azionapi.NewPurgeRequest([]string{"www.example.com/"})).Execute(); err != nil {
+		t.Fatalf("CreatePurgeRequest: %v", err)
+	}
+
+	purges := server.Purges()
+	if len(purges) != 2 || purges[0].Layer != "tiered_cache" || purges[1].Type != "url" || purges[1].Layer != "cache" {
+		t.Errorf("purges = %+v, want the tiered cache wildcard purge and the url purge", purges)
+	}
+}
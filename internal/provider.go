@@ -3,17 +3,30 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
+	"net/http"
+	"net/url"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aziontech/terraform-provider-azion/internal/consts"
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
@@ -21,7 +34,23 @@ var (
 )
 
 type AzionProviderModel struct {
-	APIToken types.String `tfsdk:"api_token"`
+	APIToken          types.String        `tfsdk:"api_token"`
+	Profile           types.String        `tfsdk:"profile"`
+	CredentialsFile   types.String        `tfsdk:"credentials_file"`
+	SkipValidation    types.Bool          `tfsdk:"skip_credentials_validation"`
+	DefaultNamePrefix types.String        `tfsdk:"default_name_prefix"`
+	RequestsPerSecond types.Float64       `tfsdk:"requests_per_second"`
+	Burst             types.Int64         `tfsdk:"burst"`
+	APIURL            types.String        `tfsdk:"api_url"`
+	Environment       types.String        `tfsdk:"environment"`
+	Retry             *AzionProviderRetry `tfsdk:"retry"`
+}
+
+type AzionProviderRetry struct {
+	MaxRetries    types.Int64  `tfsdk:"max_retries"`
+	MinBackoff    types.String `tfsdk:"min_backoff"`
+	MaxBackoff    types.String `tfsdk:"max_backoff"`
+	RetryOnStatus types.List   `tfsdk:"retry_on_status"`
 }
 
 type azionProvider struct {
@@ -42,14 +71,91 @@ func (p *azionProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 		Attributes: map[string]schema.Attribute{
 			"api_token": schema.StringAttribute{
 				Optional:    true,
-				Description: "A registered token for Azion API - https://api.azion.com/#authentication-types. Alternatively, can be configured using the environment variable - `AZION_API_TOKEN`.",
+				Description: "A registered token for Azion API - https://api.azion.com/#authentication-types. Takes precedence over `profile`. Alternatively, can be configured using the environment variable - `AZION_API_TOKEN`.",
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						regexp.MustCompile(`[A-Za-z0-9-_]{40}`),
+						regexp.MustCompile(`^[A-Za-z0-9-_]{40}$`),
 						"API tokens must be 40 characters long and only contain characters a-z, A-Z, 0-9, hyphens and underscores",
 					),
 				},
 			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the profile in the credentials file to read the API token from. Used when `api_token` is not set, and takes precedence over `AZION_API_TOKEN`. Alternatively, can be configured using the environment variable - `AZION_PROFILE`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the TOML credentials file holding the profiles. Defaults to the Azion CLI settings file, `~/.azion/settings.toml`. Alternatively, can be configured using the environment variable - `AZION_CREDENTIALS_FILE`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip the API call that validates the API token when the provider is configured. Defaults to `false`. Alternatively, can be configured using the environment variable - `AZION_SKIP_CREDENTIALS_VALIDATION`.",
+			},
+			"default_name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Prefix added to the name of the applications, firewalls, functions, network lists, connectors and workloads managed by this provider, such as `staging-`. The `name` arguments and state keep the names without the prefix, and the computed `full_name` attributes hold the names with it.",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests per second shared by all resources and data sources. Unset or `0` disables client-side rate limiting. Alternatively, can be configured using the environment variable - `AZION_REQUESTS_PER_SECOND`.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"burst": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of requests that can be sent at once before `requests_per_second` applies. Defaults to `requests_per_second` rounded up. Alternatively, can be configured using the environment variable - `AZION_BURST`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"api_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base URL of the Azion v4 API, such as a local mock server. Takes precedence over `environment`. Alternatively, can be configured using the environment variable - `AZION_API_URL`.",
+			},
+			"environment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Azion environment to manage: `production` or `stage`. Defaults to `production`. Alternatively, can be configured using the environment variable - `AZION_ENVIRONMENT`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(consts.EnvironmentProduction, consts.EnvironmentStage),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.SingleNestedBlock{
+				Description: "Retry policy for transient API failures (429, 502, 503, 504 and network errors).",
+				Attributes: map[string]schema.Attribute{
+					"max_retries": schema.Int64Attribute{
+						Optional:    true,
+						Description: fmt.Sprintf("Maximum number of retries for a single API request. Defaults to `%d`. Alternatively, can be configured using the environment variable - `AZION_MAX_RETRIES`.", utils.DefaultMaxRetries),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"min_backoff": schema.StringAttribute{
+						Optional:    true,
+						Description: fmt.Sprintf("Backoff before the first retry, as a duration such as `500ms` or `2s`. It doubles on each retry. Defaults to `%s`. Alternatively, can be configured using the environment variable - `AZION_MIN_BACKOFF`.", utils.DefaultMinBackoff),
+					},
+					"max_backoff": schema.StringAttribute{
+						Optional:    true,
+						Description: fmt.Sprintf("Upper bound for the backoff between retries, including waits requested through `Retry-After`. Defaults to `%s`. Alternatively, can be configured using the environment variable - `AZION_MAX_BACKOFF`.", utils.DefaultMaxBackoff),
+					},
+					"retry_on_status": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.Int64Type,
+						Description: "HTTP status codes that trigger a retry. Only 429 is retried for non-idempotent requests. Defaults to `[429, 502, 503, 504]`. Alternatively, can be configured using the environment variable - `AZION_RETRY_ON_STATUS` as a comma-separated list.",
+						Validators: []validator.List{
+							listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -62,23 +168,305 @@ func (p *azionProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	APIToken := os.Getenv("AZION_API_TOKEN")
-	if !config.APIToken.IsNull() {
-		APIToken = config.APIToken.ValueString()
+	APIToken := p.configureToken(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	clientConfig := newClientConfig()
+	p.configureRetry(ctx, config.Retry, &clientConfig, &resp.Diagnostics)
+	p.configureRateLimit(config, &clientConfig, &resp.Diagnostics)
+	p.configureEndpoint(config, &clientConfig, &resp.Diagnostics)
+	p.configureCassette(&clientConfig, &resp.Diagnostics)
+	clientConfig.NamePrefix = config.DefaultNamePrefix.ValueString()
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	userAgent := fmt.Sprintf(consts.UserAgentDefault, req.TerraformVersion, p.version)
 
-	client := Client(APIToken, userAgent)
+	tflog.Debug(ctx, "Creating Azion API client", map[string]any{
+		"max_retries":         clientConfig.MaxRetries,
+		"min_backoff":         clientConfig.MinBackoff.String(),
+		"max_backoff":         clientConfig.MaxBackoff.String(),
+		"retry_on_status":     clientConfig.RetryOnStatus,
+		"requests_per_second": clientConfig.RequestsPerSecond,
+		"burst":               clientConfig.Burst,
+	})
+
+	client := Client(APIToken, userAgent, clientConfig)
+	if !config.APIToken.IsUnknown() && !p.skipCredentialsValidation(config, &resp.Diagnostics) {
+		p.validateToken(ctx, client, APIToken, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// skipCredentialsValidation reports whether skip_credentials_validation or
+// AZION_SKIP_CREDENTIALS_VALIDATION is set.
+func (p *azionProvider) skipCredentialsValidation(config AzionProviderModel, diags *diag.Diagnostics) bool {
+	if !config.SkipValidation.IsNull() {
+		return config.SkipValidation.ValueBool()
+	}
+	env := os.Getenv("AZION_SKIP_CREDENTIALS_VALIDATION")
+	if env == "" {
+		return false
+	}
+	skip, err := strconv.ParseBool(env)
+	if err != nil {
+		diags.AddError(
+			"Invalid AZION_SKIP_CREDENTIALS_VALIDATION",
+			fmt.Sprintf("AZION_SKIP_CREDENTIALS_VALIDATION must be a boolean, got %q.", env),
+		)
+	}
+	return skip
+}
+
+// validateToken retrieves the account behind the API token, so that a
+// missing, invalid or expired token fails when the provider is configured
+// rather than in the first resource that uses it.
+func (p *azionProvider) validateToken(ctx context.Context, client *apiClient, APIToken string, diags *diag.Diagnostics) {
+	if APIToken == "" {
+		diags.AddError(
+			"Missing Azion API token",
+			"Set api_token or profile in the provider block, or the AZION_API_TOKEN or AZION_PROFILE environment variable. "+
+				"Set skip_credentials_validation to configure the provider without a token.",
+		)
+		return
+	}
+
+	account, response, err := client.loggedAccount(ctx)
+	if err != nil {
+		switch {
+		case response != nil && response.StatusCode == http.StatusUnauthorized:
+			detail := "The Azion API rejected the API token. Check that the token set through api_token, profile or AZION_API_TOKEN is correct and has not expired."
+			if apiErr := utils.DecodeAPIError(response); apiErr != nil {
+				detail += "\nAPI response: " + apiErr.Error()
+			}
+			diags.AddError("Invalid Azion API token", detail)
+			return
+		case response != nil && response.StatusCode == http.StatusForbidden:
+			// The token is valid but may be scoped to products other than
+			// accounts, which is fine for everything but azion_account.
+			diags.AddWarning(
+				"Unable to retrieve Azion account",
+				"The API token was accepted but is not allowed to read the account it belongs to, so the azion_account data source will not work with it.",
+			)
+			return
+		}
+		diags.AddError(
+			"Unable to validate Azion API token",
+			fmt.Sprintf("Could not retrieve the account of the API token from %s: %s", client.apiConfig.Servers[0].URL, err),
+		)
+		return
+	}
+
+	client.account = account
+	tflog.Info(ctx, "Authenticated with the Azion API", map[string]any{
+		"account_id":   account.ID,
+		"account_name": account.Name,
+		"account_type": account.Type,
+	})
+}
+
+// configureRetry resolves the retry policy from the provider block, falling
+// back to the AZION_* environment variables and then to the defaults.
+func (p *azionProvider) configureRetry(ctx context.Context, retry *AzionProviderRetry, clientConfig *clientConfig, diags *diag.Diagnostics) {
+	if retry == nil {
+		retry = &AzionProviderRetry{
+			MaxRetries:    types.Int64Null(),
+			MinBackoff:    types.StringNull(),
+			MaxBackoff:    types.StringNull(),
+			RetryOnStatus: types.ListNull(types.Int64Type),
+		}
+	}
+	retryPath := path.Root("retry")
+
+	if !retry.MaxRetries.IsNull() {
+		clientConfig.MaxRetries = int(retry.MaxRetries.ValueInt64())
+	} else if env := os.Getenv("AZION_MAX_RETRIES"); env != "" {
+		maxRetries, err := strconv.Atoi(env)
+		if err != nil || maxRetries < 0 {
+			diags.AddError(
+				"Invalid AZION_MAX_RETRIES",
+				fmt.Sprintf("AZION_MAX_RETRIES must be a non-negative integer, got %q.", env),
+			)
+		}
+		clientConfig.MaxRetries = maxRetries
+	}
+
+	clientConfig.MinBackoff = resolveDuration(retry.MinBackoff, "AZION_MIN_BACKOFF", clientConfig.MinBackoff, retryPath.AtName("min_backoff"), diags)
+	clientConfig.MaxBackoff = resolveDuration(retry.MaxBackoff, "AZION_MAX_BACKOFF", clientConfig.MaxBackoff, retryPath.AtName("max_backoff"), diags)
+	if clientConfig.MinBackoff > clientConfig.MaxBackoff {
+		diags.AddAttributeError(
+			retryPath.AtName("min_backoff"),
+			"Invalid retry backoff",
+			fmt.Sprintf("min_backoff (%s) cannot be greater than max_backoff (%s).", clientConfig.MinBackoff, clientConfig.MaxBackoff),
+		)
+	}
+
+	if !retry.RetryOnStatus.IsNull() {
+		var statuses []int64
+		diags.Append(retry.RetryOnStatus.ElementsAs(ctx, &statuses, false)...)
+		clientConfig.RetryOnStatus = make([]int, 0, len(statuses))
+		for _, status := range statuses {
+			clientConfig.RetryOnStatus = append(clientConfig.RetryOnStatus, int(status))
+		}
+	} else if env := os.Getenv("AZION_RETRY_ON_STATUS"); env != "" {
+		clientConfig.RetryOnStatus = nil
+		for _, field := range strings.Split(env, ",") {
+			status, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil || status < 400 || status > 599 {
+				diags.AddError(
+					"Invalid AZION_RETRY_ON_STATUS",
+					fmt.Sprintf("AZION_RETRY_ON_STATUS must be a comma-separated list of HTTP status codes between 400 and 599, got %q.", env),
+				)
+				return
+			}
+			clientConfig.RetryOnStatus = append(clientConfig.RetryOnStatus, status)
+		}
+	}
+}
+
+// configureRateLimit resolves the client-side rate limit from the provider
+// block, falling back to the AZION_* environment variables.
+func (p *azionProvider) configureRateLimit(config AzionProviderModel, clientConfig *clientConfig, diags *diag.Diagnostics) {
+	if !config.RequestsPerSecond.IsNull() {
+		clientConfig.RequestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	} else if env := os.Getenv("AZION_REQUESTS_PER_SECOND"); env != "" {
+		requestsPerSecond, err := strconv.ParseFloat(env, 64)
+		if err != nil || requestsPerSecond < 0 {
+			diags.AddError(
+				"Invalid AZION_REQUESTS_PER_SECOND",
+				fmt.Sprintf("AZION_REQUESTS_PER_SECOND must be a non-negative number, got %q.", env),
+			)
+		}
+		clientConfig.RequestsPerSecond = requestsPerSecond
+	}
+
+	clientConfig.Burst = int(math.Ceil(clientConfig.RequestsPerSecond))
+	if !config.Burst.IsNull() {
+		clientConfig.Burst = int(config.Burst.ValueInt64())
+	} else if env := os.Getenv("AZION_BURST"); env != "" {
+		burst, err := strconv.Atoi(env)
+		if err != nil || burst < 1 {
+			diags.AddError(
+				"Invalid AZION_BURST",
+				fmt.Sprintf("AZION_BURST must be a positive integer, got %q.", env),
+			)
+		}
+		clientConfig.Burst = burst
+	}
+}
+
+// configureEndpoint selects the API URL from environment and api_url. An
+// explicit URL takes precedence over the environment.
+func (p *azionProvider) configureEndpoint(config AzionProviderModel, clientConfig *clientConfig, diags *diag.Diagnostics) {
+	environment := consts.EnvironmentProduction
+	if !config.Environment.IsNull() {
+		environment = config.Environment.ValueString()
+	} else if env := os.Getenv("AZION_ENVIRONMENT"); env != "" {
+		environment = env
+	}
+	switch environment {
+	case consts.EnvironmentProduction:
+		clientConfig.APIURL = consts.APIURLProduction
+	case consts.EnvironmentStage:
+		clientConfig.APIURL = consts.APIURLStage
+	default:
+		diags.AddError(
+			"Invalid AZION_ENVIRONMENT",
+			fmt.Sprintf("AZION_ENVIRONMENT must be %q or %q, got %q.", consts.EnvironmentProduction, consts.EnvironmentStage, environment),
+		)
+		return
+	}
+
+	apiURL := os.Getenv("AZION_API_URL")
+	if !config.APIURL.IsNull() {
+		apiURL = config.APIURL.ValueString()
+	}
+	if apiURL != "" {
+		parsed, err := url.Parse(apiURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			detail := fmt.Sprintf("Expected an absolute http or https URL such as %q, got %q.", consts.APIURLProduction, apiURL)
+			if !config.APIURL.IsNull() {
+				diags.AddAttributeError(path.Root("api_url"), "Invalid API URL", detail)
+			} else {
+				diags.AddError("Invalid AZION_API_URL", detail)
+			}
+			return
+		}
+		clientConfig.APIURL = strings.TrimSuffix(apiURL, "/")
+	}
+}
+
+// configureCassette records the API interactions to, or replays them from,
+// the cassette in AZION_VCR_CASSETTE when AZION_VCR_MODE is set. It is meant
+// for the provider tests.
+func (p *azionProvider) configureCassette(clientConfig *clientConfig, diags *diag.Diagnostics) {
+	mode := os.Getenv("AZION_VCR_MODE")
+	if mode == "" {
+		return
+	}
+	if mode != utils.CassetteModeRecord && mode != utils.CassetteModeReplay {
+		diags.AddError(
+			"Invalid AZION_VCR_MODE",
+			fmt.Sprintf("AZION_VCR_MODE must be %q or %q, got %q.", utils.CassetteModeRecord, utils.CassetteModeReplay, mode),
+		)
+		return
+	}
+	cassette := os.Getenv("AZION_VCR_CASSETTE")
+	if cassette == "" {
+		diags.AddError(
+			"Missing AZION_VCR_CASSETTE",
+			"AZION_VCR_CASSETTE must be set to the path of the cassette file when AZION_VCR_MODE is set.",
+		)
+		return
+	}
+	transport, err := utils.OpenCassette(http.DefaultTransport, clientConfig.APIURL, mode, cassette)
+	if err != nil {
+		diags.AddError(
+			"Unable to open cassette",
+			fmt.Sprintf("Could not %s the cassette %s: %s", mode, cassette, err),
+		)
+		return
+	}
+	clientConfig.Transport = transport
+}
+
+// resolveDuration returns the duration set in the provider block, in the
+// environment variable envName, or fallback, in that order.
+func resolveDuration(value types.String, envName string, fallback time.Duration, attrPath path.Path, diags *diag.Diagnostics) time.Duration {
+	if !value.IsNull() {
+		duration, err := time.ParseDuration(value.ValueString())
+		if err != nil || duration < 0 {
+			diags.AddAttributeError(
+				attrPath,
+				"Invalid duration",
+				fmt.Sprintf("Expected a non-negative duration such as \"500ms\" or \"2s\", got %q.", value.ValueString()),
+			)
+		}
+		return duration
+	}
+	if env := os.Getenv(envName); env != "" {
+		duration, err := time.ParseDuration(env)
+		if err != nil || duration < 0 {
+			diags.AddError(
+				"Invalid "+envName,
+				fmt.Sprintf("%s must be a non-negative duration such as \"500ms\" or \"2s\", got %q.", envName, env),
+			)
+		}
+		return duration
+	}
+	return fallback
+}
+
 func (p *azionProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		dataSourceAzionAccount,
 		dataSourceAzionZone,
 		dataSourceAzionZones,
 		dataSourceAzionRecords,
@@ -121,6 +509,7 @@ func (p *azionProvider) DataSources(_ context.Context) []func() datasource.DataS
 		dataSourceAzionCrls,
 		dataSourceAzionBucket,
 		dataSourceAzionBuckets,
+		dataSourceAzionStorageObjects,
 	}
 }
 
@@ -152,6 +541,11 @@ func (p *azionProvider) Resources(_ context.Context) []func() resource.Resource
 		NewApplicationDeviceGroupResource,
 		NewCrlResource,
 		NewBucketResource,
+		NewStorageCredentialResource,
+		NewStorageObjectResource,
+		NewStorageDirectorySyncResource,
+		NewPurgeResource,
+		NewVariableResource,
 	}
 }
 
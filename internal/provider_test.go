@@ -2,10 +2,27 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
 
+	"github.com/aziontech/terraform-provider-azion/internal/consts"
+	"github.com/aziontech/terraform-provider-azion/internal/mockapi"
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const (
@@ -13,6 +30,12 @@ const (
 provider "azion" {
   api_token  = "token"
 }
+`
+
+	// mockProviderConfig configures the provider through the environment set
+	// by testAccMockAPI.
+	mockProviderConfig = `
+provider "azion" {}
 `
 )
 
@@ -27,3 +50,577 @@ var (
 		},
 	}
 )
+
+// testAccMockAPI starts the mock v4 API and points the provider at it through
+// AZION_API_URL and AZION_API_TOKEN, with short backoffs so that injected
+// faults are retried quickly.
+func testAccMockAPI(t *testing.T) *mockapi.Server {
+	t.Helper()
+	server := mockapi.NewServer()
+	t.Cleanup(server.Close)
+
+	t.Setenv("AZION_API_URL", server.URL)
+	t.Setenv("AZION_API_TOKEN", mockapi.Token)
+	t.Setenv("AZION_MIN_BACKOFF", "10ms")
+	t.Setenv("AZION_MAX_BACKOFF", "50ms")
+	return server
+}
+
+// testAccCaptureAttr stores the value of the attribute key of the resource
+// name, so that later steps can compare against it or build paths from it.
+func testAccCaptureAttr(name, key string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+		*value = rs.Primary.Attributes[key]
+		return nil
+	}
+}
+
+// testAccCheckAttrSame checks that the attribute key of the resource name
+// still has the value captured by testAccCaptureAttr, i.e. that the resource
+// was updated in place.
+func testAccCheckAttrSame(name, key string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return resource.TestCheckResourceAttr(name, key, *value)(s)
+	}
+}
+
+// testAccCheckAttrChanged checks that the attribute key of the resource name
+// no longer has the value captured by testAccCaptureAttr, i.e. that the
+// resource was replaced.
+func testAccCheckAttrChanged(name, key string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+		if got := rs.Primary.Attributes[key]; got == *value {
+			return fmt.Errorf("%s: attribute %q is still %q, want the resource to be replaced", name, key, got)
+		}
+		return nil
+	}
+}
+
+// testAccImportID returns an import ID built by joining the values of the
+// attributes keys of the resource name with "/".
+func testAccImportID(name string, keys ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", name)
+		}
+		values := make([]string, len(keys))
+		for i, key := range keys {
+			values[i] = rs.Primary.Attributes[key]
+		}
+		return strings.Join(values, "/"), nil
+	}
+}
+
+// testAccDeletedStep returns a step that deletes the object at the path
+// returned by itemPath outside of Terraform, and expects the next plan to
+// create it again, as Read must remove objects that no longer exist.
+func testAccDeletedStep(t *testing.T, server *mockapi.Server, config string, itemPath func() string) resource.TestStep {
+	return resource.TestStep{
+		PreConfig: func() {
+			if p := itemPath(); !server.Delete(p) {
+				t.Fatalf("%s not found in the mock API", p)
+			}
+		},
+		Config:             config,
+		PlanOnly:           true,
+		ExpectNonEmptyPlan: true,
+	}
+}
+
+func TestAccCassetteRecordReplay(t *testing.T) {
+	server := testAccMockAPI(t)
+	cassette := filepath.Join(t.TempDir(), "zone.json")
+	t.Cleanup(func() { utils.CloseCassette(cassette) })
+	t.Setenv("AZION_VCR_CASSETTE", cassette)
+
+	testCase := resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneConfig("example", "example.com", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azion_intelligent_dns_zone.test", "zone.domain", "example.com"),
+					resource.TestCheckResourceAttr("azion_intelligent_dns_zone.test", "zone.nameservers.#", "3"),
+				),
+			},
+		},
+	}
+
+	t.Setenv("AZION_VCR_MODE", utils.CassetteModeRecord)
+	resource.Test(t, testCase)
+
+	// The replay needs nothing but the cassette.
+	server.Close()
+	utils.CloseCassette(cassette)
+	t.Setenv("AZION_VCR_MODE", utils.CassetteModeReplay)
+	resource.Test(t, testCase)
+}
+
+// TestAccCassetteReplay replays the zone lifecycle saved in
+// testdata/cassettes/intelligent_dns_zone.json against the production API URL,
+// so it needs neither the API nor credentials. The cassette was recorded
+// against the mock API; run the test with AZION_VCR_MODE=record and
+// AZION_API_TOKEN set to record it again against the real API.
+func TestAccCassetteReplay(t *testing.T) {
+	cassette := filepath.Join("testdata", "cassettes", "intelligent_dns_zone.json")
+	t.Cleanup(func() { utils.CloseCassette(cassette) })
+	t.Setenv("AZION_VCR_CASSETTE", cassette)
+	if os.Getenv("AZION_VCR_MODE") != utils.CassetteModeRecord {
+		t.Setenv("AZION_VCR_MODE", utils.CassetteModeReplay)
+		t.Setenv("AZION_API_URL", "")
+		// The token is not checked on replay, since headers are not recorded.
+		t.Setenv("AZION_API_TOKEN", "replay")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneConfig("example", "example.com", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azion_intelligent_dns_zone.test", "zone.domain", "example.com"),
+					resource.TestCheckResourceAttr("azion_intelligent_dns_zone.test", "zone.active", "true"),
+				),
+			},
+			{
+				Config: testAccZoneConfig("example", "example.com", false),
+				Check:  resource.TestCheckResourceAttr("azion_intelligent_dns_zone.test", "zone.active", "false"),
+			},
+		},
+	})
+}
+
+func TestProviderConfigureCassette(t *testing.T) {
+	p := &azionProvider{}
+	tests := []struct {
+		name     string
+		mode     string
+		cassette string
+		wantErr  string
+	}{
+		{name: "disabled"},
+		{name: "invalid mode", mode: "rewind", wantErr: "Invalid AZION_VCR_MODE"},
+		{name: "missing cassette path", mode: utils.CassetteModeRecord, wantErr: "Missing AZION_VCR_CASSETTE"},
+		{name: "missing cassette", mode: utils.CassetteModeReplay, cassette: filepath.Join(t.TempDir(), "missing.json"), wantErr: "Unable to open cassette"},
+		{name: "record", mode: utils.CassetteModeRecord, cassette: filepath.Join(t.TempDir(), "record.json")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AZION_VCR_MODE", tt.mode)
+			t.Setenv("AZION_VCR_CASSETTE", tt.cassette)
+			t.Cleanup(func() { utils.CloseCassette(tt.cassette) })
+
+			clientConfig := newClientConfig()
+			var diags diag.Diagnostics
+			p.configureCassette(&clientConfig, &diags)
+			if tt.wantErr != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantErr {
+					t.Fatalf("diagnostics = %v, want %q", diags, tt.wantErr)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if (clientConfig.Transport != nil) != (tt.mode != "") {
+				t.Errorf("transport = %v for mode %q", clientConfig.Transport, tt.mode)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureRetry(t *testing.T) {
+	t.Setenv("AZION_MAX_RETRIES", "2")
+	t.Setenv("AZION_MAX_BACKOFF", "10s")
+	t.Setenv("AZION_RETRY_ON_STATUS", "429, 503")
+
+	p := &azionProvider{}
+	retry := &AzionProviderRetry{
+		MaxRetries:    types.Int64Null(),
+		MinBackoff:    types.StringValue("250ms"),
+		MaxBackoff:    types.StringNull(),
+		RetryOnStatus: types.ListNull(types.Int64Type),
+	}
+	clientConfig := newClientConfig()
+	var diags diag.Diagnostics
+	p.configureRetry(context.Background(), retry, &clientConfig, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if clientConfig.MaxRetries != 2 {
+		t.Errorf("MaxRetries = %d, want 2", clientConfig.MaxRetries)
+	}
+	if clientConfig.MinBackoff != 250*time.Millisecond {
+		t.Errorf("MinBackoff = %s, want 250ms", clientConfig.MinBackoff)
+	}
+	if clientConfig.MaxBackoff != 10*time.Second {
+		t.Errorf("MaxBackoff = %s, want 10s", clientConfig.MaxBackoff)
+	}
+	if !reflect.DeepEqual(clientConfig.RetryOnStatus, []int{429, 503}) {
+		t.Errorf("RetryOnStatus = %v, want [429 503]", clientConfig.RetryOnStatus)
+	}
+
+	retry.MinBackoff = types.StringValue("1m")
+	p.configureRetry(context.Background(), retry, &clientConfig, &diags)
+	if !diags.HasError() {
+		t.Error("expected an error when min_backoff exceeds max_backoff")
+	}
+}
+
+func TestProviderConfigureEndpoint(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         map[string]string
+		apiURL      types.String
+		environment types.String
+		wantAPIURL  string
+		wantError   bool
+	}{
+		{
+			name:        "default",
+			apiURL:      types.StringNull(),
+			environment: types.StringNull(),
+			wantAPIURL:  consts.APIURLProduction,
+		},
+		{
+			name:        "stage from the environment variable",
+			env:         map[string]string{"AZION_ENVIRONMENT": "stage"},
+			apiURL:      types.StringNull(),
+			environment: types.StringNull(),
+			wantAPIURL:  consts.APIURLStage,
+		},
+		{
+			name:        "api_url wins over environment",
+			env:         map[string]string{"AZION_ENVIRONMENT": "production"},
+			apiURL:      types.StringValue("http://127.0.0.1:8080/v4/"),
+			environment: types.StringValue("stage"),
+			wantAPIURL:  "http://127.0.0.1:8080/v4",
+		},
+		{
+			name:        "api url from the environment variable",
+			env:         map[string]string{"AZION_API_URL": "https://mock.example/v4"},
+			apiURL:      types.StringNull(),
+			environment: types.StringNull(),
+			wantAPIURL:  "https://mock.example/v4",
+		},
+		{
+			name:        "relative url",
+			apiURL:      types.StringValue("api.azion.com/v4"),
+			environment: types.StringNull(),
+			wantError:   true,
+		},
+		{
+			name:        "unknown environment",
+			env:         map[string]string{"AZION_ENVIRONMENT": "sandbox"},
+			apiURL:      types.StringNull(),
+			environment: types.StringNull(),
+			wantError:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"AZION_ENVIRONMENT", "AZION_API_URL"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			p := &azionProvider{}
+			clientConfig := newClientConfig()
+			var diags diag.Diagnostics
+			p.configureEndpoint(AzionProviderModel{APIURL: tt.apiURL, Environment: tt.environment}, &clientConfig, &diags)
+
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("diagnostics = %v, want error: %t", diags, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+			if clientConfig.APIURL != tt.wantAPIURL {
+				t.Errorf("APIURL = %q, want %q", clientConfig.APIURL, tt.wantAPIURL)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureToken(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "settings.toml")
+	err := os.WriteFile(file, []byte(`
+token = "cli-token"
+
+[profiles.staging]
+token = "staging-token"
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		env       map[string]string
+		config    AzionProviderModel
+		wantToken string
+		wantError bool
+	}{
+		{
+			name:      "api_token wins over everything",
+			env:       map[string]string{"AZION_API_TOKEN": "env-token", "AZION_PROFILE": "staging"},
+			config:    AzionProviderModel{APIToken: types.StringValue("config-token"), Profile: types.StringValue("staging"), CredentialsFile: types.StringValue(file)},
+			wantToken: "config-token",
+		},
+		{
+			name:      "profile wins over AZION_API_TOKEN",
+			env:       map[string]string{"AZION_API_TOKEN": "env-token"},
+			config:    AzionProviderModel{APIToken: types.StringNull(), Profile: types.StringValue("staging"), CredentialsFile: types.StringValue(file)},
+			wantToken: "staging-token",
+		},
+		{
+			name:      "AZION_API_TOKEN wins over AZION_PROFILE",
+			env:       map[string]string{"AZION_API_TOKEN": "env-token", "AZION_PROFILE": "staging", "AZION_CREDENTIALS_FILE": file},
+			config:    AzionProviderModel{APIToken: types.StringNull(), Profile: types.StringNull(), CredentialsFile: types.StringNull()},
+			wantToken: "env-token",
+		},
+		{
+			name:      "AZION_PROFILE",
+			env:       map[string]string{"AZION_PROFILE": "staging", "AZION_CREDENTIALS_FILE": file},
+			config:    AzionProviderModel{APIToken: types.StringNull(), Profile: types.StringNull(), CredentialsFile: types.StringNull()},
+			wantToken: "staging-token",
+		},
+		{
+			name:      "default profile from the Azion CLI token",
+			config:    AzionProviderModel{APIToken: types.StringNull(), Profile: types.StringNull(), CredentialsFile: types.StringValue(file)},
+			wantToken: "cli-token",
+		},
+		{
+			name:   "no credentials file",
+			env:    map[string]string{"HOME": t.TempDir()},
+			config: AzionProviderModel{APIToken: types.StringNull(), Profile: types.StringNull(), CredentialsFile: types.StringNull()},
+		},
+		{
+			name:      "unknown profile",
+			config:    AzionProviderModel{APIToken: types.StringNull(), Profile: types.StringValue("production"), CredentialsFile: types.StringValue(file)},
+			wantError: true,
+		},
+		{
+			name:      "missing credentials file",
+			config:    AzionProviderModel{APIToken: types.StringNull(), Profile: types.StringNull(), CredentialsFile: types.StringValue(filepath.Join(t.TempDir(), "missing.toml"))},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"AZION_API_TOKEN", "AZION_PROFILE", "AZION_CREDENTIALS_FILE"} {
+				t.Setenv(name, tt.env[name])
+			}
+			if home, ok := tt.env["HOME"]; ok {
+				t.Setenv("HOME", home)
+			}
+
+			p := &azionProvider{}
+			var diags diag.Diagnostics
+			token := p.configureToken(tt.config, &diags)
+
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("diagnostics = %v, want error: %t", diags, tt.wantError)
+			}
+			if token != tt.wantToken {
+				t.Errorf("token = %q, want %q", token, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestProviderValidateToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		token       string
+		status      int
+		body        string
+		wantAccount *accountInfo
+		wantError   bool
+		wantWarning bool
+	}{
+		{
+			name:        "valid token",
+			token:       "token",
+			status:      http.StatusOK,
+			body:        `{"data":{"id":1234,"name":"Example","type":"Organization","active":true}}`,
+			wantAccount: &accountInfo{ID: 1234, Name: "Example", Type: "Organization"},
+		},
+		{
+			name:      "missing token",
+			wantError: true,
+		},
+		{
+			name:      "invalid token",
+			token:     "token",
+			status:    http.StatusUnauthorized,
+			body:      `{"errors":[{"status":"401","title":"Unauthorized","detail":"Invalid token."}]}`,
+			wantError: true,
+		},
+		{
+			name:        "token without account permission",
+			token:       "token",
+			status:      http.StatusForbidden,
+			body:        `{"errors":[{"status":"403","title":"Forbidden"}]}`,
+			wantWarning: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/account/account" {
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+				if got := r.Header.Get("Authorization"); got != "token "+tt.token {
+					t.Errorf("Authorization = %q, want %q", got, "token "+tt.token)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			clientConfig := newClientConfig()
+			clientConfig.APIURL = server.URL
+			clientConfig.MaxRetries = 0
+			client := Client(tt.token, "test", clientConfig)
+
+			p := &azionProvider{}
+			var diags diag.Diagnostics
+			p.validateToken(context.Background(), client, tt.token, &diags)
+
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("diagnostics = %v, want error: %t", diags, tt.wantError)
+			}
+			if gotWarning := diags.WarningsCount() > 0; gotWarning != tt.wantWarning {
+				t.Errorf("diagnostics = %v, want warning: %t", diags, tt.wantWarning)
+			}
+			if !reflect.DeepEqual(client.account, tt.wantAccount) {
+				t.Errorf("account = %+v, want %+v", client.account, tt.wantAccount)
+			}
+		})
+	}
+}
+
+func TestClientNamePrefix(t *testing.T) {
+	clientConfig := newClientConfig()
+	clientConfig.NamePrefix = "staging-"
+	client := Client("token", "test", clientConfig)
+
+	if got := client.prefixName("api"); got != "staging-api" {
+		t.Errorf("prefixName(%q) = %q, want %q", "api", got, "staging-api")
+	}
+	for apiName, want := range map[string]string{
+		"staging-api":         "api",
+		"staging-staging-api": "staging-api",
+		"production-api":      "production-api",
+	} {
+		if got := client.unprefixName(apiName); got != want {
+			t.Errorf("unprefixName(%q) = %q, want %q", apiName, got, want)
+		}
+	}
+
+	if got := Client("token", "test", newClientConfig()).prefixName("api"); got != "api" {
+		t.Errorf("prefixName without a prefix = %q, want %q", got, "api")
+	}
+}
+
+// testAccPrefixedConfig creates each resource that takes default_name_prefix,
+// with names ending in suffix, through a provider with the prefix "tf-".
+func testAccPrefixedConfig(suffix string) string {
+	config := `
+provider "azion" {
+  default_name_prefix = "tf-"
+}
+`
+	for _, resourceConfig := range []string{
+		testAccApplicationConfig("site"+suffix, true),
+		testAccConnectorHTTPConfig("origin"+suffix, "192.0.2.10"),
+		testAccFirewallConfig("edge"+suffix, true),
+		testAccFunctionConfig("hello"+suffix, "addEventListener('fetch', () => {})"),
+		testAccNetworkListConfig("office"+suffix, `"10.0.0.0/8"`),
+		testAccWorkloadConfig("site"+suffix, `"www.example.com"`),
+	} {
+		config += strings.TrimPrefix(resourceConfig, mockProviderConfig)
+	}
+	return config
+}
+
+func TestAccDefaultNamePrefix(t *testing.T) {
+	testAccMockAPI(t)
+	checkNames := func(suffix string) resource.TestCheckFunc {
+		var checks []resource.TestCheckFunc
+		for _, named := range []struct{ resource, object, name string }{
+			{"azion_application_main_setting.test", "application", "site"},
+			{"azion_connector.test", "connector", "origin"},
+			{"azion_firewall_main_setting.test", "data", "edge"},
+			{"azion_function.test", "function", "hello"},
+			{"azion_network_list.test", "results", "office"},
+			{"azion_workload.test", "workload", "site"},
+		} {
+			checks = append(checks,
+				resource.TestCheckResourceAttr(named.resource, named.object+".name", named.name+suffix),
+				resource.TestCheckResourceAttr(named.resource, named.object+".full_name", "tf-"+named.name+suffix),
+			)
+		}
+		return resource.ComposeAggregateTestCheckFunc(checks...)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrefixedConfig(""),
+				Check:  checkNames(""),
+			},
+			{
+				// A rename keeps the prefix in the API and out of name.
+				Config: testAccPrefixedConfig("-v2"),
+				Check:  checkNames("-v2"),
+			},
+		},
+	})
+}
+
+// TestAccDataSourcesNoResponse checks that the data sources reading a single
+// object report an error, instead of panicking, when the API never answers.
+func TestAccDataSourcesNoResponse(t *testing.T) {
+	server := testAccMockAPI(t)
+	server.InjectFault(mockapi.Fault{Method: http.MethodGet, Path: "/workspace/", Drop: true})
+
+	var steps []resource.TestStep
+	for _, config := range []string{
+		`data "azion_connector" "test" { id = "1" }`,
+		`data "azion_function" "test" { id = "1" }`,
+		`data "azion_workload" "test" { id = "1" }`,
+		`data "azion_bucket" "test" { name = "assets" }`,
+		`data "azion_custom_page" "test" { id = "1" }`,
+		`data "azion_application_device_group" "test" {
+  application_id = 1
+  id             = "1"
+}`,
+		`data "azion_workload_deployment" "test" {
+  workload_id   = "1"
+  deployment_id = "1"
+}`,
+		`data "azion_intelligent_dns_zone" "test" { id = "1" }`,
+	} {
+		steps = append(steps, resource.TestStep{
+			Config:      mockProviderConfig + config,
+			ExpectError: regexp.MustCompile(`No response was received from the Azion API`),
+		})
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
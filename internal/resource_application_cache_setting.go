@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -10,6 +9,7 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -39,6 +39,7 @@ type ApplicationCacheSettingsResourceModel struct {
 	CacheSetting  *CacheSettingResourceModel `tfsdk:"cache_setting"`
 	ID            types.Int64                `tfsdk:"id"`
 	LastUpdated   types.String               `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value             `tfsdk:"timeouts"`
 }
 
 type CacheSettingResourceModel struct {
@@ -108,7 +109,7 @@ func (r *applicationCacheSettingsResource) Metadata(_ context.Context, req resou
 	resp.TypeName = req.ProviderTypeName + "_application_cache_setting"
 }
 
-func (r *applicationCacheSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *applicationCacheSettingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -119,8 +120,11 @@ func (r *applicationCacheSettingsResource) Schema(_ context.Context, _ resource.
 				},
 			},
 			"application_id": schema.Int64Attribute{
-				Description: "Numeric identifier of the Application.",
+				Description: "Numeric identifier of the Application. Changing it replaces the cache setting.",
 				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update of the resource.",
@@ -264,6 +268,14 @@ func (r *applicationCacheSettingsResource) Schema(_ context.Context, _ resource.
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -283,6 +295,14 @@ func (r *applicationCacheSettingsResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_application_cache_setting to be created")
+	defer cancel()
+
 	diagsApplicationID := req.Config.GetAttribute(ctx, path.Root("application_id"), &applicationID)
 	resp.Diagnostics.Append(diagsApplicationID...)
 	if resp.Diagnostics.HasError() {
@@ -316,32 +336,11 @@ func (r *applicationCacheSettingsResource) Create(ctx context.Context, req resou
 		CacheSettingRequest(*cacheSettingRequest).
 		Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			createdCacheSetting, response, err = utils.RetryOn429(func() (*azionapi.CacheSettingResponse, *http.Response, error) {
-				return r.client.api.ApplicationsCacheSettingsAPI.
-					CreateCacheSetting(ctx, applicationID.ValueInt64()).
-					CacheSettingRequest(*cacheSettingRequest).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(err.Error(), bodyString)
-			response.Body.Close()
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("cache_setting"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -523,6 +522,14 @@ func (r *applicationCacheSettingsResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_application_cache_setting to be read")
+	defer cancel()
+
 	applicationId := state.ApplicationID.ValueInt64()
 	cacheSettingId := state.CacheSetting.ID.ValueInt64()
 
@@ -531,37 +538,15 @@ func (r *applicationCacheSettingsResource) Read(ctx context.Context, req resourc
 		RetrieveCacheSetting(ctx, applicationId, cacheSettingId).
 		Execute()
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response != nil && response.StatusCode == 429 {
-			cacheSettingResponse, response, err = utils.RetryOn429(func() (*azionapi.CacheSettingResponse, *http.Response, error) {
-				return r.client.api.ApplicationsCacheSettingsAPI.
-					RetrieveCacheSetting(ctx, applicationId, cacheSettingId).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			if response != nil {
-				bodyBytes, errReadAll := io.ReadAll(response.Body)
-				if errReadAll != nil {
-					resp.Diagnostics.AddError(errReadAll.Error(), "err")
-				}
-				bodyString := string(bodyBytes)
-				resp.Diagnostics.AddError(err.Error(), bodyString)
-				response.Body.Close()
-			}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("cache_setting"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -605,6 +590,14 @@ func (r *applicationCacheSettingsResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_application_cache_setting to be updated")
+	defer cancel()
+
 	var state ApplicationCacheSettingsResourceModel
 	diagsOrigin := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsOrigin...)
@@ -655,32 +648,11 @@ func (r *applicationCacheSettingsResource) Update(ctx context.Context, req resou
 		PatchedCacheSettingRequest(*patchedRequest).
 		Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			updatedCacheSetting, response, err = utils.RetryOn429(func() (*azionapi.CacheSettingResponse, *http.Response, error) {
-				return r.client.api.ApplicationsCacheSettingsAPI.
-					PartialUpdateCacheSetting(ctx, applicationID.ValueInt64(), cacheID.ValueInt64()).
-					PatchedCacheSettingRequest(*patchedRequest).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(err.Error(), bodyString)
-			response.Body.Close()
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("cache_setting"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -862,10 +834,18 @@ func (r *applicationCacheSettingsResource) Delete(ctx context.Context, req resou
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_application_cache_setting to be deleted")
+	defer cancel()
+
 	applicationId := state.ApplicationID.ValueInt64()
 	cacheSettingId := state.CacheSetting.ID.ValueInt64()
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.ApplicationsCacheSettingsAPI.
 			DeleteCacheSetting(ctx, applicationId, cacheSettingId).
 			Execute()
@@ -874,15 +854,13 @@ func (r *applicationCacheSettingsResource) Delete(ctx context.Context, req resou
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(errReadAll.Error(), "err")
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(err.Error(), bodyString)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("cache_setting"), err, response)
 		return
 	}
 }
@@ -922,33 +900,8 @@ func (r *applicationCacheSettingsResource) ImportState(ctx context.Context, req
 			resp.Diagnostics.AddError("Cache setting not found", "")
 			return
 		}
-		if response != nil && response.StatusCode == 429 {
-			cacheSettingResponse, response, err = utils.RetryOn429(func() (*azionapi.CacheSettingResponse, *http.Response, error) {
-				return r.client.api.ApplicationsCacheSettingsAPI.
-					RetrieveCacheSetting(ctx, applicationId, cacheSettingId).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			if response != nil {
-				bodyBytes, errReadAll := io.ReadAll(response.Body)
-				if errReadAll != nil {
-					resp.Diagnostics.AddError(errReadAll.Error(), "err")
-				}
-				bodyString := string(bodyBytes)
-				resp.Diagnostics.AddError(err.Error(), bodyString)
-				response.Body.Close()
-			}
-			return
-		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("cache_setting"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -971,6 +924,7 @@ func (r *applicationCacheSettingsResource) ImportState(ctx context.Context, req
 		ApplicationID: types.Int64Value(applicationId),
 		CacheSetting:  transformCacheSettingResponseToResourceModel(cacheSettingData),
 		ID:            types.Int64Value(cacheSettingId),
+		Timeouts:      utils.NullTimeouts(),
 	}
 
 	diags := resp.State.Set(ctx, &state)
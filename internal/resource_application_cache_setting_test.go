@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccApplicationCacheSettingConfig(application, name string, maxAge int) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_application_main_setting" "first" {
+  application = {
+    name = "first"
+  }
+}
+
+resource "azion_application_main_setting" "second" {
+  application = {
+    name = "second"
+  }
+}
+
+resource "azion_application_cache_setting" "test" {
+  application_id = azion_application_main_setting.%s.application.application_id
+  cache_setting = {
+    name = %q
+    browser_cache = {
+      behavior = "override"
+      max_age  = %d
+    }
+    modules = {
+      cache = {
+        behavior = "override"
+        max_age  = %d
+        large_file_cache = {
+          enabled = false
+        }
+      }
+      application_accelerator = {
+        cache_vary_by_method = ["get", "head"]
+        cache_vary_by_querystring = {
+          behavior     = "allowlist"
+          fields       = ["page"]
+          sort_enabled = true
+        }
+      }
+    }
+  }
+}
+`, application, name, maxAge, maxAge)
+}
+
+func TestAccApplicationCacheSettingResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_application_cache_setting.test"
+	var applicationID, cacheSettingID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationCacheSettingConfig("first", "static", 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "application_id", "azion_application_main_setting.first", "application.application_id"),
+					resource.TestCheckResourceAttr(name, "cache_setting.name", "static"),
+					resource.TestCheckResourceAttr(name, "cache_setting.browser_cache.max_age", "3600"),
+					resource.TestCheckResourceAttr(name, "cache_setting.modules.cache.max_age", "3600"),
+					resource.TestCheckResourceAttr(name, "cache_setting.modules.application_accelerator.cache_vary_by_querystring.fields.0", "page"),
+					testAccCaptureAttr(name, "id", &cacheSettingID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID(name, "application_id", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccApplicationCacheSettingConfig("first", "static-v2", 7200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "cache_setting.name", "static-v2"),
+					resource.TestCheckResourceAttr(name, "cache_setting.browser_cache.max_age", "7200"),
+					resource.TestCheckResourceAttr(name, "cache_setting.modules.cache.max_age", "7200"),
+					testAccCheckAttrSame(name, "id", &cacheSettingID),
+				),
+			},
+			{
+				Config: testAccApplicationCacheSettingConfig("second", "static-v2", 7200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "application_id", "azion_application_main_setting.second", "application.application_id"),
+					testAccCheckAttrChanged(name, "id", &cacheSettingID),
+					testAccCaptureAttr(name, "application_id", &applicationID),
+					testAccCaptureAttr(name, "id", &cacheSettingID),
+				),
+			},
+			testAccDeletedStep(t, server, testAccApplicationCacheSettingConfig("second", "static-v2", 7200), func() string {
+				return "/workspace/applications/" + applicationID + "/cache_settings/" + cacheSettingID
+			}),
+		},
+	})
+}
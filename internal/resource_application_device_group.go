@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -11,9 +10,11 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,6 +42,7 @@ type applicationDeviceGroupResourceModel struct {
 	ID            types.String                `tfsdk:"id"`
 	LastUpdated   types.String                `tfsdk:"last_updated"`
 	SchemaVersion types.Int64                 `tfsdk:"schema_version"`
+	Timeouts      timeouts.Value              `tfsdk:"timeouts"`
 }
 
 // Device group results - all fields.
@@ -56,13 +58,16 @@ func (r *applicationDeviceGroupResource) Metadata(_ context.Context, req resourc
 	resp.TypeName = req.ProviderTypeName + "_application_device_group"
 }
 
-func (r *applicationDeviceGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *applicationDeviceGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates an application device group resource. Device groups allow you to categorize user agents (browsers, devices) using regular expression patterns.",
 		Attributes: map[string]schema.Attribute{
 			"application_id": schema.Int64Attribute{
-				Description: "The application identifier.",
+				Description: "The application identifier. Changing it replaces the device group.",
 				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -103,6 +108,14 @@ func (r *applicationDeviceGroupResource) Schema(_ context.Context, _ resource.Sc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -121,6 +134,14 @@ func (r *applicationDeviceGroupResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_application_device_group to be created")
+	defer cancel()
+
 	// Build the device group request for V4 API.
 	deviceGroupRequest := azionapi.DeviceGroupRequest{
 		Name:      plan.DeviceGroup.Name.ValueString(),
@@ -133,31 +154,11 @@ func (r *applicationDeviceGroupResource) Create(ctx context.Context, req resourc
 		DeviceGroupRequest(deviceGroupRequest).
 		Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			deviceGroupResponse, response, err = utils.RetryOn429(func() (*azionapi.DeviceGroupResponse, *http.Response, error) {
-				return r.client.api.ApplicationsDeviceGroupsAPI.
-					CreateDeviceGroup(ctx, plan.ApplicationID.ValueInt64()).
-					DeviceGroupRequest(deviceGroupRequest).
-					Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(err.Error(), bodyString)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("device_group"), err, response)
+		return
 	}
 
 	if response != nil {
@@ -194,6 +195,14 @@ func (r *applicationDeviceGroupResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_application_device_group to be read")
+	defer cancel()
+
 	// Parse the composite ID to get application_id and device_group_id.
 	applicationID, deviceGroupID, err := parseDeviceGroupID(state.ID.ValueString())
 	if err != nil {
@@ -206,33 +215,16 @@ func (r *applicationDeviceGroupResource) Read(ctx context.Context, req resource.
 		RetrieveDeviceGroup(ctx, applicationID, deviceGroupID).
 		Execute() //nolint
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-
-		if response.StatusCode == 429 {
-			deviceGroupResponse, response, err = utils.RetryOn429(func() (*azionapi.DeviceGroupResponse, *http.Response, error) {
-				return r.client.api.ApplicationsDeviceGroupsAPI.RetrieveDeviceGroup(ctx, applicationID, deviceGroupID).Execute() //nolint
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(err.Error(), bodyString)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("device_group"), err, response)
+		return
 	}
 
 	if response != nil {
@@ -264,6 +256,14 @@ func (r *applicationDeviceGroupResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_application_device_group to be updated")
+	defer cancel()
+
 	var state applicationDeviceGroupResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -290,31 +290,11 @@ func (r *applicationDeviceGroupResource) Update(ctx context.Context, req resourc
 		DeviceGroupRequest(deviceGroupRequest).
 		Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			deviceGroupResponse, response, err = utils.RetryOn429(func() (*azionapi.DeviceGroupResponse, *http.Response, error) {
-				return r.client.api.ApplicationsDeviceGroupsAPI.
-					UpdateDeviceGroup(ctx, applicationID, deviceGroupID).
-					DeviceGroupRequest(deviceGroupRequest).
-					Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(err.Error(), bodyString)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("device_group"), err, response)
+		return
 	}
 
 	if response != nil {
@@ -348,6 +328,14 @@ func (r *applicationDeviceGroupResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_application_device_group to be deleted")
+	defer cancel()
+
 	// Parse the composite ID to get application_id and device_group_id.
 	applicationID, deviceGroupID, err := parseDeviceGroupID(state.ID.ValueString())
 	if err != nil {
@@ -356,23 +344,21 @@ func (r *applicationDeviceGroupResource) Delete(ctx context.Context, req resourc
 	}
 
 	// Call the V4 API.
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.ApplicationsDeviceGroupsAPI.DeleteDeviceGroup(ctx, applicationID, deviceGroupID).Execute() //nolint
 	}, 5)
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		if response != nil && response.StatusCode == http.StatusNotFound {
 			// Resource already deleted.
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(errReadAll.Error(), "err")
-		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(err.Error(), bodyString)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("device_group"), err, response)
 		return
 	}
 }
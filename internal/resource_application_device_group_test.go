@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccApplicationDeviceGroupConfig(application, name, userAgent string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_application_main_setting" "first" {
+  application = {
+    name = "first"
+  }
+}
+
+resource "azion_application_main_setting" "second" {
+  application = {
+    name = "second"
+  }
+}
+
+resource "azion_application_device_group" "test" {
+  application_id = azion_application_main_setting.%s.application.application_id
+  device_group = {
+    name       = %q
+    user_agent = %q
+  }
+}
+`, application, name, userAgent)
+}
+
+func TestAccApplicationDeviceGroupResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_application_device_group.test"
+	var applicationID, deviceGroupID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationDeviceGroupConfig("first", "mobile", "Mobile|Android"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "device_group.name", "mobile"),
+					resource.TestCheckResourceAttr(name, "device_group.user_agent", "Mobile|Android"),
+					testAccCaptureAttr(name, "device_group.id", &deviceGroupID),
+				),
+			},
+			{
+				// The import ID is "<application_id>:<device_group_id>", as
+				// stored in id.
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccApplicationDeviceGroupConfig("first", "phones", "iPhone|Android"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "device_group.name", "phones"),
+					resource.TestCheckResourceAttr(name, "device_group.user_agent", "iPhone|Android"),
+					testAccCheckAttrSame(name, "device_group.id", &deviceGroupID),
+				),
+			},
+			{
+				Config: testAccApplicationDeviceGroupConfig("second", "phones", "iPhone|Android"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "application_id", "azion_application_main_setting.second", "application.application_id"),
+					testAccCheckAttrChanged(name, "device_group.id", &deviceGroupID),
+					testAccCaptureAttr(name, "application_id", &applicationID),
+					testAccCaptureAttr(name, "device_group.id", &deviceGroupID),
+				),
+			},
+			testAccDeletedStep(t, server, testAccApplicationDeviceGroupConfig("second", "phones", "iPhone|Android"), func() string {
+				return "/workspace/applications/" + applicationID + "/device_groups/" + deviceGroupID
+			}),
+		},
+	})
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -10,8 +9,12 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -35,6 +38,7 @@ type FunctionInstanceResourceModel struct {
 	ID            types.Int64                      `tfsdk:"id"`
 	ApplicationID types.Int64                      `tfsdk:"application_id"`
 	LastUpdated   types.String                     `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value                   `tfsdk:"timeouts"`
 }
 
 type FunctionInstanceResourceResults struct {
@@ -49,15 +53,18 @@ func (r *functionInstanceResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_application_function_instance"
 }
 
-func (r *functionInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *functionInstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Computed: true,
 			},
 			"application_id": schema.Int64Attribute{
-				Description: "The application identifier.",
+				Description: "The application identifier. Changing it replaces the function instance.",
 				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update of the resource.",
@@ -91,6 +98,14 @@ func (r *functionInstanceResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -109,6 +124,14 @@ func (r *functionInstanceResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_application_function_instance to be created")
+	defer cancel()
+
 	var argsStr string
 	if plan.Function.Args.IsUnknown() {
 		argsStr = "{}"
@@ -121,13 +144,7 @@ func (r *functionInstanceResource) Create(ctx context.Context, req resource.Crea
 		argsStr = plan.Function.Args.ValueString()
 	}
 
-	planJsonArgs, err := utils.UnmarshallJsonArgs(argsStr)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			err.Error(),
-			"err",
-		)
-	}
+	planJsonArgs := utils.FunctionArgs(argsStr)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -141,37 +158,11 @@ func (r *functionInstanceResource) Create(ctx context.Context, req resource.Crea
 
 	functionInstanceResponse, response, err := r.client.api.ApplicationsFunctionAPI.CreateApplicationFunctionInstance(ctx, plan.ApplicationID.ValueInt64()).FunctionInstanceRequest(functionInstanceRequest).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			functionInstanceResponse, response, err = utils.RetryOn429(func() (*azionapi.FunctionInstanceResponse, *http.Response, error) {
-				return r.client.api.ApplicationsFunctionAPI.CreateApplicationFunctionInstance(ctx, plan.ApplicationID.ValueInt64()).FunctionInstanceRequest(functionInstanceRequest).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
+		return
 	}
 
 	jsonArgsStr, err := utils.ConvertInterfaceToString(functionInstanceResponse.Data.GetArgs())
@@ -211,6 +202,14 @@ func (r *functionInstanceResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_application_function_instance to be read")
+	defer cancel()
+
 	var applicationID int64
 	var functionInstanceID int64
 
@@ -246,42 +245,15 @@ func (r *functionInstanceResource) Read(ctx context.Context, req resource.ReadRe
 	functionInstanceResponse, response, err := r.client.api.ApplicationsFunctionAPI.
 		RetrieveApplicationFunctionInstance(ctx, applicationID, functionInstanceID).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			functionInstanceResponse, response, err = utils.RetryOn429(func() (*azionapi.FunctionInstanceResponse, *http.Response, error) {
-				return r.client.api.ApplicationsFunctionAPI.
-					RetrieveApplicationFunctionInstance(ctx, applicationID, functionInstanceID).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
+		return
 	}
 
 	jsonArgsStr, err := utils.ConvertInterfaceToString(functionInstanceResponse.Data.GetArgs())
@@ -301,6 +273,7 @@ func (r *functionInstanceResource) Read(ctx context.Context, req resource.ReadRe
 			Args:       types.StringValue(jsonArgsStr),
 			Active:     types.BoolValue(functionInstanceResponse.Data.GetActive()),
 		},
+		Timeouts: state.Timeouts,
 	}
 
 	diags = resp.State.Set(ctx, &functionInstanceState)
@@ -319,6 +292,14 @@ func (r *functionInstanceResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_application_function_instance to be updated")
+	defer cancel()
+
 	var state FunctionInstanceResourceModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -345,13 +326,7 @@ func (r *functionInstanceResource) Update(ctx context.Context, req resource.Upda
 		argsStr = plan.Function.Args.ValueString()
 	}
 
-	requestJsonArgsStr, err := utils.UnmarshallJsonArgs(argsStr)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			err.Error(),
-			"error while unmarshalling json args",
-		)
-	}
+	requestJsonArgsStr := utils.FunctionArgs(argsStr)
 
 	patchRequest := azionapi.PatchedFunctionInstanceRequest{
 		Name:     plan.Function.Name.ValueStringPointer(),
@@ -362,37 +337,11 @@ func (r *functionInstanceResource) Update(ctx context.Context, req resource.Upda
 
 	functionInstanceUpdateResponse, response, err := r.client.api.ApplicationsFunctionAPI.PartialUpdateApplicationFunctionInstance(ctx, plan.ApplicationID.ValueInt64(), functionInstanceID.ValueInt64()).PatchedFunctionInstanceRequest(patchRequest).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			functionInstanceUpdateResponse, response, err = utils.RetryOn429(func() (*azionapi.FunctionInstanceResponse, *http.Response, error) {
-				return r.client.api.ApplicationsFunctionAPI.PartialUpdateApplicationFunctionInstance(ctx, plan.ApplicationID.ValueInt64(), functionInstanceID.ValueInt64()).PatchedFunctionInstanceRequest(patchRequest).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"error while reading response body",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
+		return
 	}
 
 	jsonArgsStr, err := utils.ConvertInterfaceToString(functionInstanceUpdateResponse.Data.GetArgs())
@@ -432,6 +381,14 @@ func (r *functionInstanceResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_application_function_instance to be deleted")
+	defer cancel()
+
 	if state.Function.ID.IsNull() {
 		resp.Diagnostics.AddError(
 			"Function Instance id error ",
@@ -448,29 +405,21 @@ func (r *functionInstanceResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.ApplicationsFunctionAPI.DeleteApplicationFunctionInstance(ctx, state.ApplicationID.ValueInt64(), state.Function.ID.ValueInt64()).Execute() //nolint
 	}, 5) // Maximum 5 retries
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		if response != nil && response.StatusCode == http.StatusNotFound {
 			// Resource already deleted, consider this a success
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
-		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
 		return
 	}
 }
@@ -501,6 +450,7 @@ func (r *functionInstanceResource) ImportState(ctx context.Context, req resource
 	state := FunctionInstanceResourceModel{
 		ApplicationID: types.Int64Value(applicationID),
 		ID:            types.Int64Value(instanceID),
+		Timeouts:      utils.NullTimeouts(),
 	}
 
 	diags := resp.State.Set(ctx, &state)
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccApplicationFunctionInstanceConfig(application, name, args string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_application_main_setting" "first" {
+  application = {
+    name = "first"
+    modules = {
+      functions = {
+        enabled = true
+      }
+    }
+  }
+}
+
+resource "azion_application_main_setting" "second" {
+  application = {
+    name = "second"
+    modules = {
+      functions = {
+        enabled = true
+      }
+    }
+  }
+}
+
+resource "azion_function" "test" {
+  function = {
+    name = "hello"
+    code = "addEventListener('fetch', () => {})"
+  }
+}
+
+resource "azion_application_function_instance" "test" {
+  application_id = azion_application_main_setting.%s.application.application_id
+  data = {
+    name        = %q
+    function_id = azion_function.test.function.id
+    args        = %q
+  }
+}
+`, application, name, args)
+}
+
+func TestAccApplicationFunctionInstanceResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_application_function_instance.test"
+	var applicationID, instanceID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationFunctionInstanceConfig("first", "hello", `{"key":"value"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "data.name", "hello"),
+					resource.TestCheckResourceAttrPair(name, "data.function_id", "azion_function.test", "function.id"),
+					resource.TestCheckResourceAttr(name, "data.args", `{"key":"value"}`),
+					testAccCaptureAttr(name, "id", &instanceID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID(name, "application_id", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccApplicationFunctionInstanceConfig("first", "hello-v2", `{"key":"other"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "data.name", "hello-v2"),
+					resource.TestCheckResourceAttr(name, "data.args", `{"key":"other"}`),
+					testAccCheckAttrSame(name, "id", &instanceID),
+				),
+			},
+			{
+				Config: testAccApplicationFunctionInstanceConfig("second", "hello-v2", `{"key":"other"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "application_id", "azion_application_main_setting.second", "application.application_id"),
+					testAccCheckAttrChanged(name, "id", &instanceID),
+					testAccCaptureAttr(name, "application_id", &applicationID),
+					testAccCaptureAttr(name, "id", &instanceID),
+				),
+			},
+			testAccDeletedStep(t, server, testAccApplicationFunctionInstanceConfig("second", "hello-v2", `{"key":"other"}`), func() string {
+				return "/workspace/applications/" + applicationID + "/functions/" + instanceID
+			}),
+		},
+	})
+}
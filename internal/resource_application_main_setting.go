@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"sync"
@@ -11,6 +10,7 @@ import (
 
 	sdk "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -39,11 +39,13 @@ type ApplicationResourceModel struct {
 	Application *ApplicationResults `tfsdk:"application"`
 	ID          types.String        `tfsdk:"id"`
 	LastUpdated types.String        `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value      `tfsdk:"timeouts"`
 }
 
 type ApplicationResults struct {
 	ApplicationID  types.Int64         `tfsdk:"application_id"`
 	Name           types.String        `tfsdk:"name"`
+	FullName       types.String        `tfsdk:"full_name"`
 	Modules        *ApplicationModules `tfsdk:"modules"`
 	Active         types.Bool          `tfsdk:"active"`
 	Debug          types.Bool          `tfsdk:"debug"`
@@ -58,7 +60,7 @@ func (r *applicationResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_application_main_setting"
 }
 
-func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *applicationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -82,6 +84,10 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 						Description: "The name of the Application.",
 						Required:    true,
 					},
+					"full_name": schema.StringAttribute{
+						Description: "Name of the application in the API, which starts with the `default_name_prefix` of the provider.",
+						Computed:    true,
+					},
 					"active": schema.BoolAttribute{
 						Optional:    true,
 						Computed:    true,
@@ -146,6 +152,14 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -170,8 +184,16 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_application_main_setting to be created")
+	defer cancel()
+
 	application := sdk.ApplicationRequest{
-		Name:   plan.Application.Name.ValueString(),
+		Name:   r.client.prefixName(plan.Application.Name.ValueString()),
 		Active: plan.Application.Active.ValueBoolPointer(),
 		Debug:  plan.Application.Debug.ValueBoolPointer(),
 	}
@@ -185,44 +207,17 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		ApplicationsAPI.CreateApplication(ctx).
 		ApplicationRequest(application).Execute() //nolint
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			createApplication, response, err = utils.RetryOn429(func() (*sdk.ApplicationResponse, *http.Response, error) {
-				return r.client.api.
-					ApplicationsAPI.CreateApplication(ctx).
-					ApplicationRequest(application).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("application"), err, response)
+		return
 	}
 
 	appResults := &ApplicationResults{
 		ApplicationID:  types.Int64Value(createApplication.Data.GetId()),
-		Name:           types.StringValue(createApplication.Data.GetName()),
+		Name:           types.StringValue(r.client.unprefixName(createApplication.Data.GetName())),
+		FullName:       types.StringValue(createApplication.Data.GetName()),
 		Active:         types.BoolValue(createApplication.Data.GetActive()),
 		Debug:          types.BoolValue(createApplication.Data.GetDebug()),
 		ProductVersion: types.StringValue(createApplication.Data.GetProductVersion()),
@@ -282,46 +277,28 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_application_main_setting to be read")
+	defer cancel()
+
 	idInt64, _ := strconv.ParseInt(state.ID.ValueString(), 10, 64)
 	stateApplication, response, err := r.client.api.
 		ApplicationsAPI.
 		RetrieveApplication(ctx, idInt64).Execute() //nolint
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response != nil && response.StatusCode == 429 {
-			stateApplication, response, err = utils.RetryOn429(func() (*sdk.ApplicationResponse, *http.Response, error) {
-				return r.client.api.ApplicationsAPI.RetrieveApplication(ctx, idInt64).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("application"), err, response)
+		return
 	}
 
 	// Preserve the prior state's Modules shape so unconfigured submodules
@@ -330,13 +307,15 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	// populate every submodule the API returned so the imported state mirrors
 	// reality.
 	var previousModules *ApplicationModules
-	if state.Application != nil {
+	importing := state.Application == nil
+	if !importing {
 		previousModules = state.Application.Modules
 	}
 
 	state.Application = &ApplicationResults{
 		ApplicationID:  types.Int64Value(stateApplication.Data.GetId()),
-		Name:           types.StringValue(stateApplication.Data.GetName()),
+		Name:           types.StringValue(r.client.unprefixName(stateApplication.Data.GetName())),
+		FullName:       types.StringValue(stateApplication.Data.GetName()),
 		Active:         types.BoolValue(stateApplication.Data.GetActive()),
 		Debug:          types.BoolValue(stateApplication.Data.GetDebug()),
 		ProductVersion: types.StringValue(stateApplication.Data.GetProductVersion()),
@@ -347,7 +326,7 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	state.ID = types.StringValue(fmt.Sprintf("%d", stateApplication.Data.GetId()))
 
-	if stateApplication.Data.Modules != nil {
+	if stateApplication.Data.Modules != nil && (importing || previousModules != nil) {
 		modelState := stateApplication.Data.GetModules()
 		modelPlan := ApplicationModules{}
 		if modelState.Cache != nil && (previousModules == nil || previousModules.Cache != nil) {
@@ -388,8 +367,16 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_application_main_setting to be updated")
+	defer cancel()
+
 	application := sdk.ApplicationRequest{
-		Name:   plan.Application.Name.ValueString(),
+		Name:   r.client.prefixName(plan.Application.Name.ValueString()),
 		Debug:  plan.Application.Debug.ValueBoolPointer(),
 		Active: plan.Application.Active.ValueBoolPointer(),
 	}
@@ -404,45 +391,17 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		UpdateApplication(ctx, idInt64).
 		ApplicationRequest(application).Execute() //nolint
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			updateApplication, response, err = utils.RetryOn429(func() (*sdk.ApplicationResponse, *http.Response, error) {
-				return r.client.api.
-					ApplicationsAPI.
-					UpdateApplication(ctx, idInt64).
-					ApplicationRequest(application).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("application"), err, response)
+		return
 	}
 
 	plan.Application = &ApplicationResults{
 		ApplicationID:  types.Int64Value(updateApplication.Data.GetId()),
-		Name:           types.StringValue(updateApplication.Data.GetName()),
+		Name:           types.StringValue(r.client.unprefixName(updateApplication.Data.GetName())),
+		FullName:       types.StringValue(updateApplication.Data.GetName()),
 		Active:         types.BoolValue(updateApplication.Data.GetActive()),
 		Debug:          types.BoolValue(updateApplication.Data.GetDebug()),
 		ProductVersion: types.StringValue(updateApplication.Data.GetProductVersion()),
@@ -471,29 +430,29 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_application_main_setting to be deleted")
+	defer cancel()
+
 	idInt64, _ := strconv.ParseInt(state.ID.ValueString(), 10, 64)
-	_, response, err := utils.RetryOn429Delete(func() (*sdk.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*sdk.DeleteResponse, *http.Response, error) {
 		return r.client.api.ApplicationsAPI.DeleteApplication(ctx, idInt64).Execute() //nolint
 	}, 5) // Maximum 5 retries
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("application"), err, response)
 		return
 	}
 }
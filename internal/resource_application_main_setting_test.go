@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccApplicationConfig(name string, cache bool) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_application_main_setting" "test" {
+  application = {
+    name = %q
+    modules = {
+      cache = {
+        enabled = %t
+      }
+      functions = {
+        enabled = true
+      }
+    }
+  }
+}
+`, name, cache)
+}
+
+func TestAccApplicationMainSettingResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_application_main_setting.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig("site", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "application.name", "site"),
+					resource.TestCheckResourceAttr(name, "application.active", "true"),
+					resource.TestCheckResourceAttr(name, "application.modules.cache.enabled", "true"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccApplicationConfig("site-v2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "application.name", "site-v2"),
+					resource.TestCheckResourceAttr(name, "application.modules.cache.enabled", "false"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccApplicationConfig("site-v2", false),
+				Taint:  []string{name},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccApplicationConfig("site-v2", false), func() string {
+				return "/workspace/applications/" + id
+			}),
+		},
+	})
+}
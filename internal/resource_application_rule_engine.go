@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -11,9 +10,12 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -40,6 +42,7 @@ type RulesEngineResourceModel struct {
 	ID            types.String                `tfsdk:"id"`
 	ApplicationID types.Int64                 `tfsdk:"application_id"`
 	LastUpdated   types.String                `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value              `tfsdk:"timeouts"`
 }
 
 type RulesEngineResourceResults struct {
@@ -95,7 +98,7 @@ func (r *rulesEngineResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_application_rule_engine"
 }
 
-func (r *rulesEngineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *rulesEngineResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -105,8 +108,11 @@ func (r *rulesEngineResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"application_id": schema.Int64Attribute{
-				Description: "The application identifier.",
+				Description: "The application identifier. Changing it replaces the rule.",
 				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"schema_version": schema.Int64Attribute{
 				Computed: true,
@@ -127,8 +133,11 @@ func (r *rulesEngineResource) Schema(_ context.Context, _ resource.SchemaRequest
 						Required:    true,
 					},
 					"phase": schema.StringAttribute{
-						Description: "The phase in which the rule is executed (request or response).",
+						Description: "The phase in which the rule is executed (request or response). Changing it replaces the rule.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"active": schema.BoolAttribute{
 						Description: "Whether the rule is active.",
@@ -240,6 +249,14 @@ func (r *rulesEngineResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -260,6 +277,14 @@ func (r *rulesEngineResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_application_rule_engine to be created")
+	defer cancel()
+
 	diagsApplicationID := req.Config.GetAttribute(ctx, path.Root("application_id"), &applicationID)
 	resp.Diagnostics.Append(diagsApplicationID...)
 	if resp.Diagnostics.HasError() {
@@ -292,40 +317,11 @@ func (r *rulesEngineResource) Create(ctx context.Context, req resource.CreateReq
 			ListApplicationRequestRules(ctx, applicationID.ValueInt64()).
 			Page(1).PageSize(2).Execute()
 		if err != nil {
-			if response.StatusCode == 429 {
-				rulesResponse, response, err = utils.RetryOn429(func() (*azionapi.PaginatedRequestPhaseRuleList, *http.Response, error) {
-					return r.client.api.ApplicationsRequestRulesAPI.
-						ListApplicationRequestRules(ctx, applicationID.ValueInt64()).
-						Page(1).PageSize(2).Execute()
-				}, 5)
-
-				if response != nil {
-					defer response.Body.Close()
-				}
-
-				if err != nil {
-					resp.Diagnostics.AddError(
-						err.Error(),
-						"API request failed after too many retries",
-					)
-					return
-				}
-			} else {
-				bodyBytes, errReadAll := io.ReadAll(response.Body)
-				if errReadAll != nil {
-					resp.Diagnostics.AddError(
-						errReadAll.Error(),
-						"err",
-					)
-				}
-				bodyString := string(bodyBytes)
-				resp.Diagnostics.AddError(
-					err.Error(),
-					bodyString,
-				)
-				response.Body.Close()
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 				return
 			}
+			utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+			return
 		}
 		if response != nil {
 			defer response.Body.Close()
@@ -370,11 +366,14 @@ func (r *rulesEngineResource) Create(ctx context.Context, req resource.CreateReq
 			defer response.Body.Close()
 		}
 		if err != nil {
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+				return
+			}
 			handleResourceAPIError(resp, response, err)
 			return
 		}
 
-		plan = buildStateFromResponse(updateResponse.Data, applicationID, types.StringValue("default"))
+		plan = buildStateFromResponse(updateResponse.Data, applicationID, types.StringValue("default"), plan.Timeouts)
 	} else {
 		// Create new rule for request or response phase
 		var rulesEngineResponse *azionapi.RequestPhaseRuleResponse
@@ -445,7 +444,7 @@ func (r *rulesEngineResource) Create(ctx context.Context, req resource.CreateReq
 			return
 		}
 
-		plan = buildStateFromResponse(rulesEngineResponse.Data, applicationID, phase)
+		plan = buildStateFromResponse(rulesEngineResponse.Data, applicationID, phase, plan.Timeouts)
 	}
 
 	diags = resp.State.Set(ctx, &plan)
@@ -463,6 +462,14 @@ func (r *rulesEngineResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_application_rule_engine to be read")
+	defer cancel()
+
 	var applicationID int64
 	var ruleID int64
 	var phase string
@@ -507,7 +514,10 @@ func (r *rulesEngineResource) Read(ctx context.Context, req resource.ReadRequest
 			defer response.Body.Close()
 		}
 		if err != nil {
-			if response.StatusCode == http.StatusNotFound {
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+				return
+			}
+			if response != nil && response.StatusCode == http.StatusNotFound {
 				resp.State.RemoveResource(ctx)
 				return
 			}
@@ -523,7 +533,10 @@ func (r *rulesEngineResource) Read(ctx context.Context, req resource.ReadRequest
 			defer response.Body.Close()
 		}
 		if err != nil {
-			if response.StatusCode == http.StatusNotFound {
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+				return
+			}
+			if response != nil && response.StatusCode == http.StatusNotFound {
 				resp.State.RemoveResource(ctx)
 				return
 			}
@@ -554,6 +567,14 @@ func (r *rulesEngineResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_application_rule_engine to be updated")
+	defer cancel()
+
 	var state RulesEngineResourceModel
 	diagsOrigin := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsOrigin...)
@@ -663,11 +684,14 @@ func (r *rulesEngineResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		handleResourceAPIError(resp, response, err)
 		return
 	}
 
-	plan = buildStateFromResponse(rulesEngineResponse.Data, applicationID, phase)
+	plan = buildStateFromResponse(rulesEngineResponse.Data, applicationID, phase, plan.Timeouts)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -684,6 +708,14 @@ func (r *rulesEngineResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_application_rule_engine to be deleted")
+	defer cancel()
+
 	if state.ApplicationID.IsNull() {
 		resp.Diagnostics.AddError(
 			"Application ID error",
@@ -732,6 +764,9 @@ func (r *rulesEngineResource) Delete(ctx context.Context, req resource.DeleteReq
 			defer response.Body.Close()
 		}
 		if err != nil {
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+				return
+			}
 			handleResourceAPIError(resp, response, err)
 			return
 		}
@@ -746,14 +781,14 @@ func (r *rulesEngineResource) Delete(ctx context.Context, req resource.DeleteReq
 		var err error
 
 		if phase == "request" {
-			_, response, err = utils.RetryOn429Delete(func() (interface{}, *http.Response, error) {
+			_, response, err = utils.RetryDeleteWhileReferenced(ctx, func() (interface{}, *http.Response, error) {
 				_, httpResp, e := r.client.api.ApplicationsRequestRulesAPI.
 					DeleteApplicationRequestRule(ctx, state.ApplicationID.ValueInt64(), state.RulesEngine.ID.ValueInt64()).
 					Execute()
 				return nil, httpResp, e
 			}, 5)
 		} else if phase == "response" {
-			_, response, err = utils.RetryOn429Delete(func() (interface{}, *http.Response, error) {
+			_, response, err = utils.RetryDeleteWhileReferenced(ctx, func() (interface{}, *http.Response, error) {
 				_, httpResp, e := r.client.api.ApplicationsResponseRulesAPI.
 					DeleteApplicationResponseRule(ctx, state.ApplicationID.ValueInt64(), state.RulesEngine.ID.ValueInt64()).
 					Execute()
@@ -766,7 +801,10 @@ func (r *rulesEngineResource) Delete(ctx context.Context, req resource.DeleteReq
 		}
 
 		if err != nil {
-			if response != nil && response.StatusCode != http.StatusNotFound {
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+				return
+			}
+			if response == nil || response.StatusCode != http.StatusNotFound {
 				handleResourceAPIError(resp, response, err)
 				return
 			}
@@ -811,6 +849,7 @@ func (r *rulesEngineResource) ImportState(ctx context.Context, req resource.Impo
 		Phase: types.StringValue(phase),
 	}
 	state.ID = types.StringValue(fmt.Sprintf("%d/%s/%d", applicationID, phase, ruleID))
+	state.Timeouts = utils.NullTimeouts()
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -917,13 +956,14 @@ func buildBehaviorsResponseV4(behaviors []RulesEngineBehaviorWrapperModel) []azi
 	return result
 }
 
-func buildStateFromResponse(rule azionapi.RequestPhaseRule, applicationID types.Int64, phase types.String) RulesEngineResourceModel {
+func buildStateFromResponse(rule azionapi.RequestPhaseRule, applicationID types.Int64, phase types.String, timeoutsValue timeouts.Value) RulesEngineResourceModel {
 	result := transformRuleToResultsModel(rule, phase.ValueString())
 	return RulesEngineResourceModel{
 		ApplicationID: applicationID,
 		ID:            types.StringValue(fmt.Sprintf("%d/%s/%d", applicationID.ValueInt64(), phase.ValueString(), result.ID.ValueInt64())),
 		LastUpdated:   types.StringValue(time.Now().Format(time.RFC850)),
 		RulesEngine:   result,
+		Timeouts:      timeoutsValue,
 	}
 }
 
@@ -1167,42 +1207,18 @@ func convertResponseToRequestPhaseRule(rule azionapi.ResponsePhaseRule) azionapi
 }
 
 func handleResourceAPIError(resp interface{}, response *http.Response, err error) {
+	var diags *diag.Diagnostics
 	switch r := resp.(type) {
 	case *resource.CreateResponse:
-		if response != nil && response.StatusCode == 429 {
-			r.Diagnostics.AddError(err.Error(), "Rate limited. Please retry.")
-		} else if response != nil {
-			bodyBytes, _ := io.ReadAll(response.Body)
-			r.Diagnostics.AddError(err.Error(), string(bodyBytes))
-		} else {
-			r.Diagnostics.AddError(err.Error(), "API request failed")
-		}
+		diags = &r.Diagnostics
 	case *resource.ReadResponse:
-		if response != nil && response.StatusCode == 429 {
-			r.Diagnostics.AddError(err.Error(), "Rate limited. Please retry.")
-		} else if response != nil {
-			bodyBytes, _ := io.ReadAll(response.Body)
-			r.Diagnostics.AddError(err.Error(), string(bodyBytes))
-		} else {
-			r.Diagnostics.AddError(err.Error(), "API request failed")
-		}
+		diags = &r.Diagnostics
 	case *resource.UpdateResponse:
-		if response != nil && response.StatusCode == 429 {
-			r.Diagnostics.AddError(err.Error(), "Rate limited. Please retry.")
-		} else if response != nil {
-			bodyBytes, _ := io.ReadAll(response.Body)
-			r.Diagnostics.AddError(err.Error(), string(bodyBytes))
-		} else {
-			r.Diagnostics.AddError(err.Error(), "API request failed")
-		}
+		diags = &r.Diagnostics
 	case *resource.DeleteResponse:
-		if response != nil && response.StatusCode == 429 {
-			r.Diagnostics.AddError(err.Error(), "Rate limited. Please retry.")
-		} else if response != nil {
-			bodyBytes, _ := io.ReadAll(response.Body)
-			r.Diagnostics.AddError(err.Error(), string(bodyBytes))
-		} else {
-			r.Diagnostics.AddError(err.Error(), "API request failed")
-		}
+		diags = &r.Diagnostics
+	default:
+		return
 	}
+	utils.AddAPIErrorAt(diags, path.Root("results"), err, response)
 }
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"sort"
 	"strconv"
@@ -12,10 +11,13 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -37,18 +39,19 @@ type applicationRuleEngineOrderResource struct {
 }
 
 type applicationRuleEngineOrderModel struct {
-	ID            types.String  `tfsdk:"id"`
-	ApplicationID types.Int64   `tfsdk:"application_id"`
-	Phase         types.String  `tfsdk:"phase"`
-	Order         []types.Int64 `tfsdk:"order"`
-	LastUpdated   types.String  `tfsdk:"last_updated"`
+	ID            types.String   `tfsdk:"id"`
+	ApplicationID types.Int64    `tfsdk:"application_id"`
+	Phase         types.String   `tfsdk:"phase"`
+	Order         []types.Int64  `tfsdk:"order"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *applicationRuleEngineOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application_rule_engine_order"
 }
 
-func (r *applicationRuleEngineOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *applicationRuleEngineOrderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -58,12 +61,18 @@ func (r *applicationRuleEngineOrderResource) Schema(_ context.Context, _ resourc
 				},
 			},
 			"application_id": schema.Int64Attribute{
-				Description: "The application identifier whose rules are being ordered.",
+				Description: "The application identifier whose rules are being ordered. Changing it replaces the resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"phase": schema.StringAttribute{
-				Description: "The rule phase to order. Must be 'request' or 'response'.",
+				Description: "The rule phase to order. Must be 'request' or 'response'. Changing it replaces the resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOf("request", "response"),
 				},
@@ -78,6 +87,14 @@ func (r *applicationRuleEngineOrderResource) Schema(_ context.Context, _ resourc
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -96,6 +113,14 @@ func (r *applicationRuleEngineOrderResource) Create(ctx context.Context, req res
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_application_rule_engine_order to be created")
+	defer cancel()
+
 	r.applyOrder(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -116,6 +141,14 @@ func (r *applicationRuleEngineOrderResource) Read(ctx context.Context, req resou
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_application_rule_engine_order to be read")
+	defer cancel()
+
 	applicationID, phase, ok := parseOrderID(state.ID.ValueString(), state.ApplicationID, state.Phase, resp)
 	if !ok {
 		return
@@ -123,6 +156,9 @@ func (r *applicationRuleEngineOrderResource) Read(ctx context.Context, req resou
 
 	currentOrder, removed, err := r.listOrderedRuleIDs(ctx, applicationID, phase)
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		if removed {
 			resp.State.RemoveResource(ctx)
 			return
@@ -147,6 +183,14 @@ func (r *applicationRuleEngineOrderResource) Update(ctx context.Context, req res
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_application_rule_engine_order to be updated")
+	defer cancel()
+
 	var state applicationRuleEngineOrderModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -206,7 +250,7 @@ func (r *applicationRuleEngineOrderResource) ImportState(ctx context.Context, re
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("phase"), phase)...)
 }
 
-func (r *applicationRuleEngineOrderResource) applyOrder(ctx context.Context, plan *applicationRuleEngineOrderModel, diags diagAccumulator) {
+func (r *applicationRuleEngineOrderResource) applyOrder(ctx context.Context, plan *applicationRuleEngineOrderModel, diags *diag.Diagnostics) {
 	applicationID := plan.ApplicationID.ValueInt64()
 	phase := plan.Phase.ValueString()
 
@@ -234,24 +278,8 @@ func (r *applicationRuleEngineOrderResource) applyOrder(ctx context.Context, pla
 			defer response.Body.Close()
 		}
 		if err != nil {
-			if response != nil && response.StatusCode == 429 {
-				_, response, err = utils.RetryOn429(func() (*azionapi.PaginatedRequestPhaseRuleList, *http.Response, error) {
-					return r.client.api.ApplicationsRequestRulesAPI.
-						UpdateApplicationRequestRulesOrder(ctx, applicationID).
-						ApplicationRequestPhaseRuleEngineOrder(*body).
-						Execute()
-				}, 5)
-				if response != nil {
-					defer response.Body.Close()
-				}
-				if err != nil {
-					diags.AddError(err.Error(), "API request failed after too many retries")
-					return
-				}
-			} else {
-				appendBodyError(diags, response, err)
-				return
-			}
+			utils.AddAPIErrorAt(diags, path.Root("order"), err, response)
+			return
 		}
 	case "response":
 		body := azionapi.NewApplicationResponsePhaseRuleEngineOrderRequest(orderIDs)
@@ -263,24 +291,8 @@ func (r *applicationRuleEngineOrderResource) applyOrder(ctx context.Context, pla
 			defer response.Body.Close()
 		}
 		if err != nil {
-			if response != nil && response.StatusCode == 429 {
-				_, response, err = utils.RetryOn429(func() (*azionapi.PaginatedResponsePhaseRuleList, *http.Response, error) {
-					return r.client.api.ApplicationsResponseRulesAPI.
-						UpdateApplicationResponseRulesOrder(ctx, applicationID).
-						ApplicationResponsePhaseRuleEngineOrderRequest(*body).
-						Execute()
-				}, 5)
-				if response != nil {
-					defer response.Body.Close()
-				}
-				if err != nil {
-					diags.AddError(err.Error(), "API request failed after too many retries")
-					return
-				}
-			} else {
-				appendBodyError(diags, response, err)
-				return
-			}
+			utils.AddAPIErrorAt(diags, path.Root("order"), err, response)
+			return
 		}
 	default:
 		diags.AddError("Invalid phase", fmt.Sprintf("Phase must be 'request' or 'response', got: %s", phase))
@@ -378,26 +390,6 @@ func (r *applicationRuleEngineOrderResource) fetchRulePage(ctx context.Context,
 	}
 }
 
-// diagAccumulator is the subset of diag.Diagnostics used by applyOrder, so it can be
-// invoked from any of the CRUD methods without per-method duplication.
-type diagAccumulator interface {
-	AddError(summary, detail string)
-	AddWarning(summary, detail string)
-}
-
-func appendBodyError(diags diagAccumulator, response *http.Response, err error) {
-	if response == nil {
-		diags.AddError(err.Error(), "API request failed")
-		return
-	}
-	bodyBytes, readErr := io.ReadAll(response.Body)
-	if readErr != nil {
-		diags.AddError(readErr.Error(), "failed to read response body")
-		return
-	}
-	diags.AddError(err.Error(), string(bodyBytes))
-}
-
 func parseOrderID(rawID string, fallbackAppID types.Int64, fallbackPhase types.String, resp *resource.ReadResponse) (int64, string, bool) {
 	parts := strings.Split(rawID, "/")
 	if len(parts) == 2 {
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// testAccApplicationRuleEngineOrderConfig manages the Default Rule and two
+// rules in each phase of an application, and orders the rules of phase as
+// listed in order, which refers to the rules by their resource names.
+func testAccApplicationRuleEngineOrderConfig(phase, order string) string {
+	rules := ""
+	for _, p := range []string{"request", "response"} {
+		for _, name := range []string{"first", "second"} {
+			rules += fmt.Sprintf(`
+resource "azion_application_rule_engine" "%[1]s_%[2]s" {
+  application_id = azion_application_main_setting.test.application.application_id
+  results = {
+    name  = "%[1]s-%[2]s"
+    phase = %[1]q
+    criteria = [
+      {
+        entries = [
+          {
+            criterion = {
+              conditional = "if"
+              variable    = "$${uri}"
+              operator    = "exists"
+            }
+          }
+        ]
+      }
+    ]
+    behaviors = [
+      {
+        behavior = {
+          type = "deny"
+        }
+      }
+    ]
+  }
+}
+`, p, name)
+		}
+	}
+
+	return mockProviderConfig + `
+resource "azion_application_main_setting" "test" {
+  application = {
+    name = "site"
+  }
+}
+
+resource "azion_application_rule_engine" "default" {
+  application_id = azion_application_main_setting.test.application.application_id
+  results = {
+    name  = "Default Rule"
+    phase = "default"
+    criteria = [
+      {
+        entries = [
+          {
+            criterion = {
+              conditional = "if"
+              variable    = "$${uri}"
+              operator    = "exists"
+            }
+          }
+        ]
+      }
+    ]
+    behaviors = [
+      {
+        behavior = {
+          type = "deliver"
+        }
+      }
+    ]
+  }
+}
+` + rules + fmt.Sprintf(`
+resource "azion_application_rule_engine_order" "test" {
+  application_id = azion_application_main_setting.test.application.application_id
+  phase          = %q
+  order          = [%s]
+}
+`, phase, order)
+}
+
+func TestAccApplicationRuleEngineOrderResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_application_rule_engine_order.test"
+	var applicationID string
+
+	requestOrder := func(first, second string) string {
+		return fmt.Sprintf("azion_application_rule_engine.default.results.id, azion_application_rule_engine.request_%s.results.id, azion_application_rule_engine.request_%s.results.id", first, second)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationRuleEngineOrderConfig("request", requestOrder("second", "first")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "order.#", "3"),
+					resource.TestCheckResourceAttrPair(name, "order.0", "azion_application_rule_engine.default", "results.id"),
+					resource.TestCheckResourceAttrPair(name, "order.1", "azion_application_rule_engine.request_second", "results.id"),
+					resource.TestCheckResourceAttrPair(name, "order.2", "azion_application_rule_engine.request_first", "results.id"),
+					testAccCaptureAttr(name, "application_id", &applicationID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID(name, "application_id", "phase"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccApplicationRuleEngineOrderConfig("request", requestOrder("first", "second")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttrSame(name, "application_id", &applicationID),
+					resource.TestCheckResourceAttrPair(name, "order.1", "azion_application_rule_engine.request_first", "results.id"),
+					resource.TestCheckResourceAttrPair(name, "order.2", "azion_application_rule_engine.request_second", "results.id"),
+				),
+			},
+			{
+				Config: testAccApplicationRuleEngineOrderConfig("response", "azion_application_rule_engine.response_second.results.id, azion_application_rule_engine.response_first.results.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "phase", "response"),
+					resource.TestCheckResourceAttrPair(name, "order.0", "azion_application_rule_engine.response_second", "results.id"),
+					resource.TestCheckResourceAttrPair(name, "order.1", "azion_application_rule_engine.response_first", "results.id"),
+				),
+			},
+			testAccDeletedStep(t, server, testAccApplicationRuleEngineOrderConfig("response", "azion_application_rule_engine.response_second.results.id, azion_application_rule_engine.response_first.results.id"), func() string {
+				return "/workspace/applications/" + applicationID
+			}),
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccApplicationRuleEngineConfig(phase, name, variable string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_application_main_setting" "test" {
+  application = {
+    name = "site"
+  }
+}
+
+resource "azion_application_rule_engine" "test" {
+  application_id = azion_application_main_setting.test.application.application_id
+  results = {
+    name  = %q
+    phase = %q
+    criteria = [
+      {
+        entries = [
+          {
+            criterion = {
+              conditional = "if"
+              variable    = %q
+              operator    = "exists"
+            }
+          }
+        ]
+      }
+    ]
+    behaviors = [
+      {
+        behavior = {
+          type = "deny"
+        }
+      }
+    ]
+  }
+}
+`, name, phase, variable)
+}
+
+func TestAccApplicationRuleEngineResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_application_rule_engine.test"
+	var applicationID, ruleID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationRuleEngineConfig("request", "deny", "$${uri}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.name", "deny"),
+					resource.TestCheckResourceAttr(name, "results.phase", "request"),
+					resource.TestCheckResourceAttr(name, "results.behaviors.0.behavior.type", "deny"),
+					resource.TestCheckResourceAttr(name, "results.criteria.0.entries.0.criterion.variable", "${uri}"),
+					testAccCaptureAttr(name, "results.id", &ruleID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID(name, "application_id", "results.phase", "results.id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccApplicationRuleEngineConfig("request", "deny-host", "$${host}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.name", "deny-host"),
+					resource.TestCheckResourceAttr(name, "results.criteria.0.entries.0.criterion.variable", "${host}"),
+					testAccCheckAttrSame(name, "results.id", &ruleID),
+				),
+			},
+			{
+				Config: testAccApplicationRuleEngineConfig("response", "deny-host", "$${host}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.phase", "response"),
+					testAccCheckAttrChanged(name, "results.id", &ruleID),
+					testAccCaptureAttr(name, "application_id", &applicationID),
+					testAccCaptureAttr(name, "results.id", &ruleID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID(name, "application_id", "results.phase", "results.id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			testAccDeletedStep(t, server, testAccApplicationRuleEngineConfig("response", "deny-host", "$${host}"), func() string {
+				return "/workspace/applications/" + applicationID + "/response_rules/" + ruleID
+			}),
+		},
+	})
+}
@@ -3,12 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,6 +36,7 @@ type bucketResourceModel struct {
 	Bucket      *bucketResourceResults `tfsdk:"bucket"`
 	ID          types.String           `tfsdk:"id"`
 	LastUpdated types.String           `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value         `tfsdk:"timeouts"`
 }
 
 type bucketResourceResults struct {
@@ -50,7 +51,7 @@ func (r *bucketResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_bucket"
 }
 
-func (r *bucketResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *bucketResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Resource for managing Azion Storage Buckets.",
 		Attributes: map[string]schema.Attribute{
@@ -93,6 +94,14 @@ func (r *bucketResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -111,6 +120,14 @@ func (r *bucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_bucket to be created")
+	defer cancel()
+
 	bucket := azionapi.NewBucketCreateRequest(
 		plan.Bucket.Name.ValueString(),
 		plan.Bucket.WorkloadsAccess.ValueString(),
@@ -121,40 +138,11 @@ func (r *bucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		BucketCreateRequest(*bucket).
 		Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			createBucket, response, err = utils.RetryOn429(func() (*azionapi.BucketCreateResponse, *http.Response, error) {
-				return r.client.api.StorageBucketsAPI.
-					CreateBucket(ctx).
-					BucketCreateRequest(*bucket).
-					Execute() //nolint
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("bucket"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -179,6 +167,14 @@ func (r *bucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_bucket to be read")
+	defer cancel()
+
 	var bucketName string
 	if state.Bucket != nil {
 		bucketName = state.Bucket.Name.ValueString()
@@ -190,43 +186,15 @@ func (r *bucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		RetrieveBucket(ctx, bucketName).
 		Execute() //nolint
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			getBucket, response, err = utils.RetryOn429(func() (*azionapi.BucketCreateResponse, *http.Response, error) {
-				return r.client.api.StorageBucketsAPI.
-					RetrieveBucket(ctx, bucketName).
-					Execute() //nolint
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("bucket"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -250,6 +218,14 @@ func (r *bucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_bucket to be updated")
+	defer cancel()
+
 	var state bucketResourceModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -269,40 +245,11 @@ func (r *bucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 		PatchedBucketRequest(*updateBucketRequest).
 		Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			updateBucket, response, err = utils.RetryOn429(func() (*azionapi.BucketCreateResponse, *http.Response, error) {
-				return r.client.api.StorageBucketsAPI.
-					UpdateBucket(ctx, bucketName).
-					PatchedBucketRequest(*updateBucketRequest).
-					Execute() //nolint
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("bucket"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -327,9 +274,17 @@ func (r *bucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_bucket to be deleted")
+	defer cancel()
+
 	bucketName := state.Bucket.Name.ValueString()
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.StorageBucketsAPI.
 			DeleteBucket(ctx, bucketName).
 			Execute() //nolint
@@ -338,21 +293,13 @@ func (r *bucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("bucket"), err, response)
 		return
 	}
 }
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccBucketConfig(name, access string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_bucket" "test" {
+  bucket = {
+    name             = %q
+    workloads_access = %q
+  }
+}
+`, name, access)
+}
+
+func TestAccBucketResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_bucket.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig("assets", "read_only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", "assets"),
+					resource.TestCheckResourceAttr(name, "bucket.workloads_access", "read_only"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccBucketConfig("assets", "read_write"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "bucket.workloads_access", "read_write"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccBucketConfig("media", "read_write"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", "media"),
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccBucketConfig("media", "read_write"), func() string {
+				return "/workspace/storage/buckets/" + id
+			}),
+		},
+	})
+}
@@ -3,15 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -40,6 +41,7 @@ type certificateRequestResourceModel struct {
 	Results       *certificateRequestResultsModel `tfsdk:"results"`
 	ID            types.String                    `tfsdk:"id"`
 	LastUpdated   types.String                    `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value                  `tfsdk:"timeouts"`
 }
 
 // certificateRequestResultsModel represents the certificate request data in Terraform state.
@@ -74,12 +76,12 @@ func (r *certificateRequestResource) Metadata(_ context.Context, req resource.Me
 }
 
 // Schema for certificate request (Let's Encrypt).
-func (r *certificateRequestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *certificateRequestResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a certificate request resource for Let's Encrypt certificates. " +
 			"This resource allows you to request SSL/TLS certificates from Let's Encrypt automatically.\n\n" +
-			"~> **Note:** This resource only supports creation. Update operations are not available. " +
-			"Read and Delete operations use the standard digital certificates endpoint.\n\n" +
+			"~> **Note:** The request API only provides a POST endpoint for creation. Read, Update, and Delete operations use the standard digital certificates endpoint, " +
+			"which can only change `name`. Changing any other argument replaces the resource.\n\n" +
 			"~> **Note about challenge types:**\n" +
 			"Use `dns` challenge for DNS-based validation or `http` challenge for HTTP-based validation. " +
 			"The challenge type determines how Let's Encrypt will verify domain ownership.",
@@ -114,12 +116,19 @@ func (r *certificateRequestResource) Schema(_ context.Context, _ resource.Schema
 					"common_name": schema.StringAttribute{
 						Description: "Common Name (CN) for the certificate. This is the primary domain name.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"alternative_names": schema.ListAttribute{
 						Description: "Subject Alternative Names (SANs) for the certificate. Additional domain names to include.",
 						Optional:    true,
 						Computed:    true,
 						ElementType: types.StringType,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+							listplanmodifier.RequiresReplaceIfConfigured(),
+						},
 					},
 					"issuer": schema.StringAttribute{
 						Description: "Issuer of the certificate.",
@@ -158,16 +167,26 @@ func (r *certificateRequestResource) Schema(_ context.Context, _ resource.Schema
 						Description: "Challenge type for ACME certificate validation. " +
 							"Options: `dns` (Uses DNS to solve the ACME challenge), `http` (Uses HTTP to solve the ACME challenge).",
 						Required: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"authority": schema.StringAttribute{
 						Description: "Certificate authority. Options: `lets_encrypt`.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"key_algorithm": schema.StringAttribute{
 						Description: "Key algorithm used for the certificate. " +
 							"Options: `rsa_2048` (2048-bit RSA), `rsa_4096` (4096-bit RSA), `ecc_384` (384-bit Prime Field Curve).",
 						Optional: true,
 						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplaceIfConfigured(),
+						},
 					},
 					"active": schema.BoolAttribute{
 						Description: "Whether the certificate is active.",
@@ -206,6 +225,14 @@ func (r *certificateRequestResource) Schema(_ context.Context, _ resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -224,6 +251,14 @@ func (r *certificateRequestResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_certificate_request to be created")
+	defer cancel()
+
 	// Build the certificate request for V4 API.
 	certificateRequest := azionapi.NewCertificateRequest(
 		plan.Results.Name.ValueString(),
@@ -250,37 +285,11 @@ func (r *certificateRequestResource) Create(ctx context.Context, req resource.Cr
 	// Call the V4 API - Request Certificate endpoint (Let's Encrypt).
 	certificateResponse, response, err := r.client.api.DigitalCertificatesRequestACertificateAPI.RequestCertificate(ctx).CertificateRequest(*certificateRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			certificateResponse, response, err = utils.RetryOn429(func() (*azionapi.CertificateResponse, *http.Response, error) {
-				return r.client.api.DigitalCertificatesRequestACertificateAPI.RequestCertificate(ctx).CertificateRequest(*certificateRequest).Execute()
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -309,8 +318,22 @@ func (r *certificateRequestResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_certificate_request to be read")
+	defer cancel()
+
+	// The results are not set yet when the resource is imported.
+	resultsID := types.Int64Null()
+	if state.Results != nil {
+		resultsID = state.Results.ID
+	}
+
 	// Get the certificate ID from state.
-	certificateID, err := parseCertificateRequestID(state.ID, state.Results.ID)
+	certificateID, err := parseCertificateRequestID(state.ID, resultsID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Value Conversion error",
@@ -322,41 +345,15 @@ func (r *certificateRequestResource) Read(ctx context.Context, req resource.Read
 	// Call the V4 API - Use the regular certificates endpoint to read.
 	certificateResponse, response, err := r.client.api.DigitalCertificatesCertificatesAPI.RetrieveCertificate(ctx, certificateID).Execute()
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			certificateResponse, response, err = utils.RetryOn429(func() (*azionapi.CertificateResponse, *http.Response, error) {
-				return r.client.api.DigitalCertificatesCertificatesAPI.RetrieveCertificate(ctx, certificateID).Execute()
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -376,12 +373,66 @@ func (r *certificateRequestResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *certificateRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// The Certificate Request API does not have an UPDATE endpoint.
-	// Let's Encrypt certificates cannot be updated - they must be recreated.
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Certificate requests cannot be updated. To change a certificate, you must destroy and recreate the resource.",
-	)
+	var plan certificateRequestResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_certificate_request to be updated")
+	defer cancel()
+
+	var state certificateRequestResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificateID, err := parseCertificateRequestID(state.ID, state.Results.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Value Conversion error",
+			err.Error(),
+		)
+		return
+	}
+
+	// The Certificate Request API does not have an UPDATE endpoint; the
+	// requested domains require replacement and the name is updated through
+	// the regular certificates endpoint.
+	certificateRequest := azionapi.PatchedCertificate{}
+	certificateRequest.SetName(plan.Results.Name.ValueString())
+
+	certificateResponse, response, err := r.client.api.DigitalCertificatesCertificatesAPI.PartialUpdateCertificate(ctx, certificateID).PatchedCertificate(certificateRequest).Execute()
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
+	}
+	if response != nil {
+		defer response.Body.Close()
+	}
+
+	cert := certificateResponse.GetData()
+	plan.Results = populateCertificateRequestResultsFromAPI(ctx, cert)
+	plan.SchemaVersion = types.Int64Value(1)
+	plan.ID = types.StringValue(fmt.Sprintf("%d", cert.GetId()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *certificateRequestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -392,6 +443,14 @@ func (r *certificateRequestResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_certificate_request to be deleted")
+	defer cancel()
+
 	// Get the certificate ID from state.
 	certificateID, err := parseCertificateRequestID(state.ID, state.Results.ID)
 	if err != nil {
@@ -403,28 +462,20 @@ func (r *certificateRequestResource) Delete(ctx context.Context, req resource.De
 	}
 
 	// Call the V4 API to delete the certificate - Use the regular certificates endpoint.
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.DigitalCertificatesCertificatesAPI.DeleteCertificate(ctx, certificateID).Execute()
 	}, 5) // Maximum 5 retries
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
 		return
 	}
 }
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccCertificateRequestConfig(name, commonName string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_certificate_request" "test" {
+  results = {
+    name              = %q
+    common_name       = %q
+    alternative_names = ["www.%s"]
+    challenge         = "dns"
+    authority         = "lets_encrypt"
+  }
+}
+`, name, commonName, commonName)
+}
+
+func TestAccCertificateRequestResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_certificate_request.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateRequestConfig("lets-encrypt", "example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.name", "lets-encrypt"),
+					resource.TestCheckResourceAttr(name, "results.common_name", "example.com"),
+					resource.TestCheckResourceAttr(name, "results.alternative_names.0", "www.example.com"),
+					resource.TestCheckResourceAttr(name, "results.status", "pending"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccCertificateRequestConfig("lets-encrypt-v2", "example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.name", "lets-encrypt-v2"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccCertificateRequestConfig("lets-encrypt-v2", "example.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.common_name", "example.org"),
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccCertificateRequestConfig("lets-encrypt-v2", "example.org"), func() string {
+				return "/workspace/tls/certificates/" + id
+			}),
+		},
+	})
+}
@@ -2,16 +2,20 @@ package provider
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -40,6 +44,7 @@ type certificateSigningRequestResourceModel struct {
 	Results       *certificateSigningRequestResultsModel `tfsdk:"results"`
 	ID            types.String                           `tfsdk:"id"`
 	LastUpdated   types.String                           `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value                         `tfsdk:"timeouts"`
 }
 
 // certificateSigningRequestResultsModel represents the CSR data in Terraform state.
@@ -80,11 +85,11 @@ func (r *certificateSigningRequestResource) Metadata(_ context.Context, req reso
 }
 
 // Schema for certificate signing request.
-func (r *certificateSigningRequestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *certificateSigningRequestResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a certificate signing request (CSR) resource. This resource allows you to create certificate signing requests.\n\n" +
-			"~> **Note:** This resource supports Create, Read, and Delete operations. The CSR API only provides a POST endpoint for creation. " +
-			"Read and Delete operations use the standard digital certificates endpoint. Update operations are not supported - any changes will require resource recreation.\n\n" +
+			"~> **Note:** The CSR API only provides a POST endpoint for creation. Read, Update, and Delete operations use the standard digital certificates endpoint, " +
+			"which can only change `name`, `active`, and `certificate`. Changing any other argument replaces the resource.\n\n" +
 			"~> **Note about private_key and certificate:**\n" +
 			"Parameters `private_key` and `certificate` are sensitive and can be specified using `local_file` from the [local provider](https://registry.terraform.io/providers/hashicorp/local/latest/docs/resources/file).",
 		Attributes: map[string]schema.Attribute{
@@ -118,47 +123,79 @@ func (r *certificateSigningRequestResource) Schema(_ context.Context, _ resource
 					"common_name": schema.StringAttribute{
 						Description: "Common Name (CN) for the certificate.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"country": schema.StringAttribute{
 						Description: "Country code (C) for the certificate subject.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"state": schema.StringAttribute{
 						Description: "State or province name (ST) for the certificate subject.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"locality": schema.StringAttribute{
 						Description: "Locality or city name (L) for the certificate subject.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"organization": schema.StringAttribute{
 						Description: "Organization name (O) for the certificate subject.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"organization_unity": schema.StringAttribute{
 						Description: "Organizational unit name (OU) for the certificate subject.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"email": schema.StringAttribute{
 						Description: "Email address for the certificate subject.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"alternative_names": schema.ListAttribute{
 						Description: "Subject Alternative Names (SANs) for the certificate.",
 						Optional:    true,
 						ElementType: types.StringType,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.RequiresReplace(),
+						},
 					},
 					"certificate_type": schema.StringAttribute{
 						Description: "Type of the certificate. The value can't be changed after the certificate creation. " +
 							"Options: `edge_certificate` (Edge Certificate), `trusted_ca_certificate` (Trusted CA Certificate).",
 						Optional: true,
 						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplaceIfConfigured(),
+						},
 					},
 					"key_algorithm": schema.StringAttribute{
 						Description: "Key algorithm for the certificate. Options: " +
 							"`rsa_2048` (2048-bit RSA), `rsa_4096` (4096-bit RSA), `ecc_384` (384-bit Prime Field Curve).",
 						Optional: true,
 						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplaceIfConfigured(),
+						},
 					},
 					"active": schema.BoolAttribute{
 						Description: "Whether the certificate is active.",
@@ -166,16 +203,23 @@ func (r *certificateSigningRequestResource) Schema(_ context.Context, _ resource
 						Computed:    true,
 					},
 					"certificate": schema.StringAttribute{
-						Description: "The certificate content (PEM format).",
+						Description: "The certificate content (PEM format), uploaded once the CSR is signed.",
 						Optional:    true,
 						Computed:    true,
 						Sensitive:   true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
 					},
 					"private_key": schema.StringAttribute{
 						Description: "Private key for the certificate (PEM format).",
 						Optional:    true,
 						Computed:    true,
 						Sensitive:   true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplaceIfConfigured(),
+						},
 					},
 					"issuer": schema.StringAttribute{
 						Description: "Issuer of the certificate.",
@@ -237,6 +281,14 @@ func (r *certificateSigningRequestResource) Schema(_ context.Context, _ resource
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -255,6 +307,14 @@ func (r *certificateSigningRequestResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_certificate_signing_request to be created")
+	defer cancel()
+
 	// Build the CSR request for V4 API.
 	csrRequest := azionapi.CertificateSigningRequest{
 		Name:              plan.Results.Name.ValueString(),
@@ -301,37 +361,11 @@ func (r *certificateSigningRequestResource) Create(ctx context.Context, req reso
 	// Call the V4 API.
 	certificateResponse, response, err := r.client.api.DigitalCertificatesCertificateSigningRequestsAPI.CreateCertificateSigningRequest(ctx).CertificateSigningRequest(csrRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			certificateResponse, response, err = utils.RetryOn429(func() (*azionapi.CertificateResponse, *http.Response, error) {
-				return r.client.api.DigitalCertificatesCertificateSigningRequestsAPI.CreateCertificateSigningRequest(ctx).CertificateSigningRequest(csrRequest).Execute()
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -360,8 +394,21 @@ func (r *certificateSigningRequestResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	// Get the certificate ID from state.
-	certificateID, err := parseCSRID(state.ID, state.Results.ID)
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_certificate_signing_request to be read")
+	defer cancel()
+
+	// Get the certificate ID from state. On import only the top-level id is set.
+	importing := state.Results == nil
+	resultsID := types.Int64Null()
+	if !importing {
+		resultsID = state.Results.ID
+	}
+	certificateID, err := parseCSRID(state.ID, resultsID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Value Conversion error",
@@ -373,41 +420,15 @@ func (r *certificateSigningRequestResource) Read(ctx context.Context, req resour
 	// Call the V4 API - Use the regular certificates endpoint to read.
 	certificateResponse, response, err := r.client.api.DigitalCertificatesCertificatesAPI.RetrieveCertificate(ctx, certificateID).Execute()
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			certificateResponse, response, err = utils.RetryOn429(func() (*azionapi.CertificateResponse, *http.Response, error) {
-				return r.client.api.DigitalCertificatesCertificatesAPI.RetrieveCertificate(ctx, certificateID).Execute()
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -415,7 +436,11 @@ func (r *certificateSigningRequestResource) Read(ctx context.Context, req resour
 	}
 
 	// Populate the state from the API response while preserving input fields.
+	// The API does not return them, so an import recovers them from the CSR.
 	cert := certificateResponse.GetData()
+	if importing {
+		state.Results = csrSubjectResults(ctx, cert.GetCsr())
+	}
 	state.Results = populateCSRResultsFromAPI(ctx, cert, state.Results)
 	state.SchemaVersion = types.Int64Value(1)
 
@@ -427,7 +452,6 @@ func (r *certificateSigningRequestResource) Read(ctx context.Context, req resour
 }
 
 func (r *certificateSigningRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// The CSR API does not have an UPDATE endpoint, so we just return the current state.
 	var plan certificateSigningRequestResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -435,7 +459,59 @@ func (r *certificateSigningRequestResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	// Return the plan unchanged.
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_certificate_signing_request to be updated")
+	defer cancel()
+
+	var state certificateSigningRequestResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificateID, err := parseCSRID(state.ID, state.Results.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Value Conversion error",
+			err.Error(),
+		)
+		return
+	}
+
+	// The CSR API has no update endpoint; the subject fields require replacement
+	// and the rest is updated through the regular certificates endpoint.
+	certificateRequest := azionapi.PatchedCertificate{}
+	certificateRequest.SetName(plan.Results.Name.ValueString())
+	if !plan.Results.Active.IsNull() && !plan.Results.Active.IsUnknown() {
+		certificateRequest.SetActive(plan.Results.Active.ValueBool())
+	}
+	if !plan.Results.Certificate.IsNull() && !plan.Results.Certificate.IsUnknown() {
+		certificateRequest.SetCertificate(plan.Results.Certificate.ValueString())
+	}
+
+	certificateResponse, response, err := r.client.api.DigitalCertificatesCertificatesAPI.PartialUpdateCertificate(ctx, certificateID).PatchedCertificate(certificateRequest).Execute()
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
+	}
+	if response != nil {
+		defer response.Body.Close()
+	}
+
+	cert := certificateResponse.GetData()
+	plan.Results = populateCSRResultsFromAPI(ctx, cert, plan.Results)
+	plan.SchemaVersion = types.Int64Value(1)
+	plan.ID = types.StringValue(fmt.Sprintf("%d", cert.GetId()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -451,6 +527,14 @@ func (r *certificateSigningRequestResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_certificate_signing_request to be deleted")
+	defer cancel()
+
 	// Get the certificate ID from state.
 	certificateID, err := parseCSRID(state.ID, state.Results.ID)
 	if err != nil {
@@ -462,28 +546,20 @@ func (r *certificateSigningRequestResource) Delete(ctx context.Context, req reso
 	}
 
 	// Call the V4 API to delete the certificate - Use the regular certificates endpoint.
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.DigitalCertificatesCertificatesAPI.DeleteCertificate(ctx, certificateID).Execute()
 	}, 5) // Maximum 5 retries
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
 		return
 	}
 }
@@ -587,6 +663,51 @@ func populateCSRResultsFromAPI(ctx context.Context, cert azionapi.Certificate, o
 	return result
 }
 
+// csrSubjectResults returns the subject fields encoded in a PEM CSR, which
+// are left null when it cannot be parsed.
+func csrSubjectResults(ctx context.Context, csr string) *certificateSigningRequestResultsModel {
+	result := &certificateSigningRequestResultsModel{
+		AlternativeNames: types.ListNull(types.StringType),
+	}
+
+	block, _ := pem.Decode([]byte(csr))
+	if block == nil {
+		return result
+	}
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return result
+	}
+
+	first := func(values []string) types.String {
+		if len(values) == 0 {
+			return types.StringNull()
+		}
+		return types.StringValue(values[0])
+	}
+	subject := request.Subject
+	result.CommonName = types.StringValue(subject.CommonName)
+	result.Country = first(subject.Country)
+	result.State = first(subject.Province)
+	result.Locality = first(subject.Locality)
+	result.Organization = first(subject.Organization)
+	result.OrganizationUnity = first(subject.OrganizationalUnit)
+	result.Email = first(request.EmailAddresses)
+	for _, name := range subject.Names {
+		if email, ok := name.Value.(string); ok && name.Type.Equal(oidEmailAddress) {
+			result.Email = types.StringValue(email)
+		}
+	}
+	if len(request.DNSNames) > 0 {
+		result.AlternativeNames, _ = types.ListValueFrom(ctx, types.StringType, request.DNSNames)
+	}
+
+	return result
+}
+
+// oidEmailAddress is the subject attribute holding the email of a CSR.
+var oidEmailAddress = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 1}
+
 // handleOptionalString handles optional string fields that may be unknown.
 // If the value is unknown, it returns a null string; otherwise, it preserves the original value.
 func handleOptionalString(value types.String) types.String {
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccCertificateSigningRequestConfig(name, commonName string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_certificate_signing_request" "test" {
+  results = {
+    name               = %q
+    common_name        = %q
+    country            = "BR"
+    state              = "RS"
+    locality           = "Porto Alegre"
+    organization       = "Example"
+    organization_unity = "IT"
+    email              = "admin@example.com"
+    alternative_names  = ["www.example.com"]
+  }
+}
+`, name, commonName)
+}
+
+func TestAccCertificateSigningRequestResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_certificate_signing_request.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateSigningRequestConfig("edge", "example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.name", "edge"),
+					resource.TestCheckResourceAttr(name, "results.common_name", "example.com"),
+					resource.TestCheckResourceAttr(name, "results.status", "pending"),
+					resource.TestCheckResourceAttrSet(name, "results.csr"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccCertificateSigningRequestConfig("edge-v2", "example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.name", "edge-v2"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccCertificateSigningRequestConfig("edge-v2", "example.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.common_name", "example.org"),
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccCertificateSigningRequestConfig("edge-v2", "example.org"), func() string {
+				return "/workspace/tls/certificates/" + id
+			}),
+		},
+	})
+}
@@ -3,14 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -39,12 +40,14 @@ type connectorResourceModel struct {
 	ID            types.String              `tfsdk:"id"`
 	LastUpdated   types.String              `tfsdk:"last_updated"`
 	SchemaVersion types.Int64               `tfsdk:"schema_version"`
+	Timeouts      timeouts.Value            `tfsdk:"timeouts"`
 }
 
 // Connector results - all fields including type-specific attributes.
 type connectorResourceResults struct {
 	ID             types.Int64             `tfsdk:"id"`
 	Name           types.String            `tfsdk:"name"`
+	FullName       types.String            `tfsdk:"full_name"`
 	LastEditor     types.String            `tfsdk:"last_editor"`
 	LastModified   types.String            `tfsdk:"last_modified"`
 	CreatedAt      types.String            `tfsdk:"created_at"`
@@ -170,7 +173,7 @@ func (r *connectorResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_connector"
 }
 
-func (r *connectorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *connectorResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates a connector resource. Connectors are polymorphic and support different types (http, storage).",
 		Attributes: map[string]schema.Attribute{
@@ -198,6 +201,10 @@ func (r *connectorResource) Schema(_ context.Context, _ resource.SchemaRequest,
 						Description: "Name of the connector.",
 						Required:    true,
 					},
+					"full_name": schema.StringAttribute{
+						Description: "Name of the connector in the API, which starts with the `default_name_prefix` of the provider.",
+						Computed:    true,
+					},
 					"last_editor": schema.StringAttribute{
 						Description: "The last editor of the connector.",
 						Computed:    true,
@@ -220,8 +227,11 @@ func (r *connectorResource) Schema(_ context.Context, _ resource.SchemaRequest,
 						Computed:    true,
 					},
 					"type": schema.StringAttribute{
-						Description: "Type of the connector (http or storage).",
+						Description: "Type of the connector (http or storage). Changing it replaces the connector.",
 						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"is_versioned": schema.BoolAttribute{
 						Description: "Whether the connector is versioned.",
@@ -461,6 +471,14 @@ func (r *connectorResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -479,13 +497,21 @@ func (r *connectorResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_connector to be created")
+	defer cancel()
+
 	connectorType := plan.Connector.Type.ValueString()
 	var connectorId int64
 
 	// Build the appropriate request based on connector type.
 	switch connectorType {
 	case "storage":
-		connectorReq, err := buildStorageConnectorRequest(plan.Connector)
+		connectorReq, err := r.buildStorageConnectorRequest(plan.Connector)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				err.Error(),
@@ -498,27 +524,20 @@ func (r *connectorResource) Create(ctx context.Context, req resource.CreateReque
 			defer response.Body.Close()
 		}
 		if err != nil {
-			if response != nil && response.StatusCode == http.StatusTooManyRequests {
-				createConnector, response, err = utils.RetryOn429(func() (*azionapi.ConnectorResponse, *http.Response, error) {
-					return r.client.api.ConnectorsAPI.CreateConnector(ctx).ConnectorRequest(connectorReq).Execute()
-				}, 5)
-				if response != nil {
-					defer response.Body.Close()
-				}
-				if err != nil {
-					resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-					return
-				}
-			} else {
-				addConnectorAPIError(&resp.Diagnostics, err, response, "create")
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 				return
 			}
+			addConnectorAPIError(&resp.Diagnostics, err, response)
+			return
 		}
 		connectorId = getConnectorId(createConnector.GetData())
 
 	case "http":
 		connectorReq, err := r.buildHTTPConnectorRequest(ctx, plan.Connector)
 		if err != nil {
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+				return
+			}
 			resp.Diagnostics.AddError(
 				err.Error(),
 				"Failed to build HTTP connector request",
@@ -530,21 +549,11 @@ func (r *connectorResource) Create(ctx context.Context, req resource.CreateReque
 			defer response.Body.Close()
 		}
 		if err != nil {
-			if response != nil && response.StatusCode == http.StatusTooManyRequests {
-				createConnector, response, err = utils.RetryOn429(func() (*azionapi.ConnectorResponse, *http.Response, error) {
-					return r.client.api.ConnectorsAPI.CreateConnector(ctx).ConnectorRequest(connectorReq).Execute()
-				}, 5)
-				if response != nil {
-					defer response.Body.Close()
-				}
-				if err != nil {
-					resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-					return
-				}
-			} else {
-				addConnectorAPIError(&resp.Diagnostics, err, response, "create")
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 				return
 			}
+			addConnectorAPIError(&resp.Diagnostics, err, response)
+			return
 		}
 		connectorId = getConnectorId(createConnector.GetData())
 
@@ -562,21 +571,11 @@ func (r *connectorResource) Create(ctx context.Context, req resource.CreateReque
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusTooManyRequests {
-			getConnector, response, err = utils.RetryOn429(func() (*azionapi.ConnectorResponse, *http.Response, error) {
-				return r.client.api.ConnectorsAPI.RetrieveConnector(ctx, connectorId).Execute()
-			}, 5)
-			if response != nil {
-				defer response.Body.Close()
-			}
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			addConnectorAPIError(&resp.Diagnostics, err, response, "read after create")
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		addConnectorAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
 	r.populateConnectorFromResponse(ctx, plan.Connector, getConnector.GetData())
@@ -616,6 +615,14 @@ func (r *connectorResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_connector to be read")
+	defer cancel()
+
 	var connectorId int64
 	var err error
 	if state.Connector != nil {
@@ -636,25 +643,15 @@ func (r *connectorResource) Read(ctx context.Context, req resource.ReadRequest,
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response != nil && response.StatusCode == http.StatusTooManyRequests {
-			getConnector, response, err = utils.RetryOn429(func() (*azionapi.ConnectorResponse, *http.Response, error) {
-				return r.client.api.ConnectorsAPI.RetrieveConnector(ctx, connectorId).Execute()
-			}, 5)
-			if response != nil {
-				defer response.Body.Close()
-			}
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			addConnectorAPIError(&resp.Diagnostics, err, response, "read")
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		addConnectorAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
 	r.populateConnectorFromResponse(ctx, state.Connector, getConnector.GetData())
@@ -676,6 +673,14 @@ func (r *connectorResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_connector to be updated")
+	defer cancel()
+
 	var state connectorResourceModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -689,7 +694,7 @@ func (r *connectorResource) Update(ctx context.Context, req resource.UpdateReque
 	// Build and send the appropriate update request based on connector type.
 	switch connectorType {
 	case "storage":
-		connectorReq, err := buildStoragePatchedConnectorRequest(plan.Connector)
+		connectorReq, err := r.buildStoragePatchedConnectorRequest(plan.Connector)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				err.Error(),
@@ -702,27 +707,20 @@ func (r *connectorResource) Update(ctx context.Context, req resource.UpdateReque
 			defer response.Body.Close()
 		}
 		if err != nil {
-			if response != nil && response.StatusCode == http.StatusTooManyRequests {
-				updateConnector, response, err = utils.RetryOn429(func() (*azionapi.ConnectorResponse, *http.Response, error) {
-					return r.client.api.ConnectorsAPI.PartialUpdateConnector(ctx, connectorId).PatchedConnectorRequest(connectorReq).Execute()
-				}, 5)
-				if response != nil {
-					defer response.Body.Close()
-				}
-				if err != nil {
-					resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-					return
-				}
-			} else {
-				addConnectorAPIError(&resp.Diagnostics, err, response, "update")
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 				return
 			}
+			addConnectorAPIError(&resp.Diagnostics, err, response)
+			return
 		}
 		r.populateConnectorFromResponse(ctx, plan.Connector, updateConnector.GetData())
 
 	case "http":
 		connectorReq, err := r.buildHTTPPatchedConnectorRequest(ctx, plan.Connector)
 		if err != nil {
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+				return
+			}
 			resp.Diagnostics.AddError(
 				err.Error(),
 				"Failed to build HTTP connector update request",
@@ -734,21 +732,11 @@ func (r *connectorResource) Update(ctx context.Context, req resource.UpdateReque
 			defer response.Body.Close()
 		}
 		if err != nil {
-			if response != nil && response.StatusCode == http.StatusTooManyRequests {
-				updateConnector, response, err = utils.RetryOn429(func() (*azionapi.ConnectorResponse, *http.Response, error) {
-					return r.client.api.ConnectorsAPI.PartialUpdateConnector(ctx, connectorId).PatchedConnectorRequest(connectorReq).Execute()
-				}, 5)
-				if response != nil {
-					defer response.Body.Close()
-				}
-				if err != nil {
-					resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-					return
-				}
-			} else {
-				addConnectorAPIError(&resp.Diagnostics, err, response, "update")
+			if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 				return
 			}
+			addConnectorAPIError(&resp.Diagnostics, err, response)
+			return
 		}
 		r.populateConnectorFromResponse(ctx, plan.Connector, updateConnector.GetData())
 
@@ -779,19 +767,30 @@ func (r *connectorResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_connector to be deleted")
+	defer cancel()
+
 	connectorId := state.Connector.ID.ValueInt64()
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.ConnectorsAPI.DeleteConnector(ctx, connectorId).Execute()
 	}, 5)
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		if response != nil && response.StatusCode == http.StatusNotFound {
 			return
 		}
-		addConnectorAPIError(&resp.Diagnostics, err, response, "delete")
+		addConnectorAPIError(&resp.Diagnostics, err, response)
 		return
 	}
 }
@@ -812,26 +811,14 @@ func (r *connectorResource) ImportState(ctx context.Context, req resource.Import
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusTooManyRequests {
-			getConnector, response, err = utils.RetryOn429(func() (*azionapi.ConnectorResponse, *http.Response, error) {
-				return r.client.api.ConnectorsAPI.RetrieveConnector(ctx, connectorId).Execute()
-			}, 5)
-			if response != nil {
-				defer response.Body.Close()
-			}
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			addConnectorAPIError(&resp.Diagnostics, err, response, "import")
-			return
-		}
+		addConnectorAPIError(&resp.Diagnostics, err, response)
+		return
 	}
 
 	// Create the model and populate it from response
 	state := &connectorResourceModel{
 		Connector: &connectorResourceResults{},
+		Timeouts:  utils.NullTimeouts(),
 	}
 	r.populateConnectorFromResponse(ctx, state.Connector, getConnector.GetData())
 	state.ID = types.StringValue(strconv.FormatInt(connectorId, 10))
@@ -843,7 +830,7 @@ func (r *connectorResource) ImportState(ctx context.Context, req resource.Import
 
 // Helper functions for building requests.
 
-func buildStorageConnectorRequest(connector *connectorResourceResults) (azionapi.ConnectorRequest, error) {
+func (r *connectorResource) buildStorageConnectorRequest(connector *connectorResourceResults) (azionapi.ConnectorRequest, error) {
 	if connector.StorageAttrs == nil {
 		return azionapi.ConnectorRequest{}, fmt.Errorf("storage_attributes is required for storage type connectors")
 	}
@@ -857,7 +844,7 @@ func buildStorageConnectorRequest(connector *connectorResourceResults) (azionapi
 	}
 
 	req := azionapi.NewConnectorStorageRequest(
-		connector.Name.ValueString(),
+		r.client.prefixName(connector.Name.ValueString()),
 		connector.Type.ValueString(),
 		attrs,
 	)
@@ -886,7 +873,7 @@ func (r *connectorResource) buildHTTPConnectorRequest(_ context.Context, connect
 	}
 
 	req := azionapi.NewConnectorHTTPRequest(
-		connector.Name.ValueString(),
+		r.client.prefixName(connector.Name.ValueString()),
 		connector.Type.ValueString(),
 		*attrs,
 	)
@@ -1041,7 +1028,7 @@ func buildHTTPModulesRequest(m *HTTPModulesModel) *azionapi.HTTPModulesRequest {
 	return modules
 }
 
-func buildStoragePatchedConnectorRequest(connector *connectorResourceResults) (azionapi.PatchedConnectorRequest, error) {
+func (r *connectorResource) buildStoragePatchedConnectorRequest(connector *connectorResourceResults) (azionapi.PatchedConnectorRequest, error) {
 	if connector.StorageAttrs == nil {
 		return azionapi.PatchedConnectorRequest{}, fmt.Errorf("storage_attributes is required for storage type connectors")
 	}
@@ -1055,7 +1042,7 @@ func buildStoragePatchedConnectorRequest(connector *connectorResourceResults) (a
 	}
 
 	req := azionapi.NewPatchedConnectorStorageRequest(connector.Type.ValueString())
-	req.SetName(connector.Name.ValueString())
+	req.SetName(r.client.prefixName(connector.Name.ValueString()))
 	req.SetAttributes(attrs)
 
 	if !connector.Active.IsNull() && !connector.Active.IsUnknown() {
@@ -1082,7 +1069,7 @@ func (r *connectorResource) buildHTTPPatchedConnectorRequest(_ context.Context,
 	}
 
 	req := azionapi.NewPatchedConnectorHTTPRequest(connector.Type.ValueString())
-	req.SetName(connector.Name.ValueString())
+	req.SetName(r.client.prefixName(connector.Name.ValueString()))
 	req.SetAttributes(attrs)
 
 	if !connector.Active.IsNull() && !connector.Active.IsUnknown() {
@@ -1102,7 +1089,8 @@ func (r *connectorResource) populateConnectorFromResponse(ctx context.Context, m
 	case *azionapi.ConnectorStorage:
 		// Storage connector.
 		model.ID = types.Int64Value(c.Id)
-		model.Name = types.StringValue(c.Name)
+		model.Name = types.StringValue(r.client.unprefixName(c.Name))
+		model.FullName = types.StringValue(c.Name)
 		model.LastEditor = types.StringValue(c.LastEditor)
 		model.LastModified = types.StringValue(c.LastModified.Format(time.RFC850))
 		model.CreatedAt = types.StringValue(c.CreatedAt.Format(time.RFC850))
@@ -1132,7 +1120,8 @@ func (r *connectorResource) populateConnectorFromResponse(ctx context.Context, m
 		priorHTTPAttrs := model.HTTPAttrs
 
 		model.ID = types.Int64Value(c.Id)
-		model.Name = types.StringValue(c.Name)
+		model.Name = types.StringValue(r.client.unprefixName(c.Name))
+		model.FullName = types.StringValue(c.Name)
 		model.LastEditor = types.StringValue(c.LastEditor)
 		model.LastModified = types.StringValue(c.LastModified.Format(time.RFC850))
 		model.CreatedAt = types.StringValue(c.CreatedAt.Format(time.RFC850))
@@ -1388,27 +1377,8 @@ func populateHTTPModules(prior *HTTPModulesModel, m *azionapi.HTTPModules) *HTTP
 	return out
 }
 
-// addConnectorAPIError adds an appropriate error to diagnostics based on the API response.
-func addConnectorAPIError(diagnostics *diag.Diagnostics, err error, response *http.Response, _ string) {
-	if response == nil {
-		diagnostics.AddError(err.Error(), "No response received")
-		return
-	}
-
-	if response.StatusCode == 429 {
-		// Rate limiting.
-		diagnostics.AddError(
-			err.Error(),
-			"API request rate limited",
-		)
-		return
-	}
-
-	bodyBytes, errReadAll := io.ReadAll(response.Body)
-	if errReadAll != nil {
-		diagnostics.AddError(errReadAll.Error(), "err")
-		return
-	}
-	bodyString := string(bodyBytes)
-	diagnostics.AddError(err.Error(), bodyString)
+// addConnectorAPIError adds the decoded API error to diagnostics, pointing
+// field errors at the matching connector attribute.
+func addConnectorAPIError(diagnostics *diag.Diagnostics, err error, response *http.Response) {
+	utils.AddAPIErrorAt(diagnostics, path.Root("connector"), err, response)
 }
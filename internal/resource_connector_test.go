@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccConnectorHTTPConfig(name, address string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_connector" "test" {
+  connector = {
+    name   = %q
+    type   = "http"
+    active = true
+    http_attributes = {
+      addresses = [
+        {
+          endpoint = {
+            address   = %q
+            http_port = 80
+            active    = true
+          }
+        }
+      ]
+    }
+  }
+}
+`, name, address)
+}
+
+func testAccConnectorStorageConfig(name, bucket string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_connector" "test" {
+  connector = {
+    name   = %q
+    type   = "storage"
+    active = true
+    storage_attributes = {
+      bucket = %q
+      prefix = "assets/"
+    }
+  }
+}
+`, name, bucket)
+}
+
+func TestAccConnectorResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_connector.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorHTTPConfig("origin", "192.0.2.10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "connector.name", "origin"),
+					resource.TestCheckResourceAttr(name, "connector.type", "http"),
+					resource.TestCheckResourceAttr(name, "connector.http_attributes.addresses.0.endpoint.address", "192.0.2.10"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccConnectorHTTPConfig("origin-v2", "192.0.2.20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "connector.name", "origin-v2"),
+					resource.TestCheckResourceAttr(name, "connector.http_attributes.addresses.0.endpoint.address", "192.0.2.20"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccConnectorStorageConfig("origin-v2", "assets"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "connector.type", "storage"),
+					resource.TestCheckResourceAttr(name, "connector.storage_attributes.bucket", "assets"),
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			testAccDeletedStep(t, server, testAccConnectorStorageConfig("origin-v2", "assets"), func() string {
+				return "/workspace/connectors/" + id
+			}),
+		},
+	})
+}
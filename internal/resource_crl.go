@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -10,6 +9,8 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -37,6 +38,7 @@ type crlResourceModel struct {
 	Crl         *crlResourceResults `tfsdk:"crl"`
 	ID          types.String        `tfsdk:"id"`
 	LastUpdated types.String        `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value      `tfsdk:"timeouts"`
 }
 
 type crlResourceResults struct {
@@ -57,7 +59,7 @@ func (r *crlResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_crl"
 }
 
-func (r *crlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *crlResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates a Certificate Revocation List (CRL) resource.",
 		Attributes: map[string]schema.Attribute{
@@ -123,6 +125,14 @@ func (r *crlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -167,6 +177,14 @@ func (r *crlResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_crl to be created")
+	defer cancel()
+
 	// Build the request.
 	crlRequest := azionapi.NewCertificateRevocationListWithDefaults()
 	crlRequest.SetName(plan.Crl.Name.ValueString())
@@ -187,40 +205,11 @@ func (r *crlResource) Create(ctx context.Context, req resource.CreateRequest, re
 		CertificateRevocationList(*crlRequest).
 		Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			createCrl, response, err = utils.RetryOn429(func() (*azionapi.CertificateRevocationListResponse, *http.Response, error) {
-				return r.client.api.DigitalCertificatesCertificateRevocationListsAPI.
-					CreateCertificateRevocationList(ctx).
-					CertificateRevocationList(*crlRequest).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("crl"), err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -248,6 +237,14 @@ func (r *crlResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_crl to be read")
+	defer cancel()
+
 	var crlID int64
 	var err error
 	if state.Crl != nil {
@@ -267,43 +264,15 @@ func (r *crlResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		RetrieveCertificateRevocationList(ctx, crlID).
 		Execute()
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			getCrl, response, err = utils.RetryOn429(func() (*azionapi.CertificateRevocationListResponse, *http.Response, error) {
-				return r.client.api.DigitalCertificatesCertificateRevocationListsAPI.
-					RetrieveCertificateRevocationList(ctx, crlID).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("crl"), err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -329,6 +298,14 @@ func (r *crlResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_crl to be updated")
+	defer cancel()
+
 	var state crlResourceModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -368,40 +345,11 @@ func (r *crlResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		PatchedCertificateRevocationList(*patchedCrl).
 		Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			updateCrl, response, err = utils.RetryOn429(func() (*azionapi.CertificateRevocationListResponse, *http.Response, error) {
-				return r.client.api.DigitalCertificatesCertificateRevocationListsAPI.
-					PartialUpdateCertificateRevocationList(ctx, crlID).
-					PatchedCertificateRevocationList(*patchedCrl).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("crl"), err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -428,6 +376,14 @@ func (r *crlResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_crl to be deleted")
+	defer cancel()
+
 	var crlID int64
 	var err error
 	if state.Crl != nil {
@@ -443,7 +399,7 @@ func (r *crlResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		}
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.DigitalCertificatesCertificateRevocationListsAPI.
 			DeleteCertificateRevocationList(ctx, crlID).
 			Execute()
@@ -452,21 +408,13 @@ func (r *crlResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("crl"), err, response)
 		return
 	}
 }
@@ -486,51 +434,18 @@ func (r *crlResource) ImportState(ctx context.Context, req resource.ImportStateR
 		RetrieveCertificateRevocationList(ctx, crlID).
 		Execute()
 	if err != nil {
-		if response != nil {
-			if response.StatusCode == 429 {
-				getCrl, response, err = utils.RetryOn429(func() (*azionapi.CertificateRevocationListResponse, *http.Response, error) {
-					return r.client.api.DigitalCertificatesCertificateRevocationListsAPI.
-						RetrieveCertificateRevocationList(ctx, crlID).
-						Execute()
-				}, 5)
-
-				if response != nil {
-					defer response.Body.Close()
-				}
-
-				if err != nil {
-					resp.Diagnostics.AddError(
-						err.Error(),
-						"API request failed after too many retries",
-					)
-					return
-				}
-			} else {
-				bodyBytes, errReadAll := io.ReadAll(response.Body)
-				if errReadAll != nil {
-					resp.Diagnostics.AddError(
-						errReadAll.Error(),
-						"err",
-					)
-				}
-				bodyString := string(bodyBytes)
-				resp.Diagnostics.AddError(
-					err.Error(),
-					bodyString,
-				)
-				return
-			}
-		}
-	} else {
-		if response != nil {
-			defer response.Body.Close()
-		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("crl"), err, response)
+		return
+	}
+	if response != nil {
+		defer response.Body.Close()
 	}
 
 	crlData := getCrl.GetData()
 	state := crlResourceModel{
-		Crl: populateCrlResourceResults(crlData),
-		ID:  types.StringValue(strconv.FormatInt(crlData.GetId(), 10)),
+		Crl:      populateCrlResourceResults(crlData),
+		ID:       types.StringValue(strconv.FormatInt(crlData.GetId(), 10)),
+		Timeouts: utils.NullTimeouts(),
 	}
 
 	diags := resp.State.Set(ctx, &state)
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccCrlContent = "-----BEGIN X509 CRL-----\nMOCK\n-----END X509 CRL-----"
+
+func testAccCrlConfig(name string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_crl" "test" {
+  crl = {
+    name   = %q
+    issuer = "CN=Mock CA"
+    crl    = %q
+  }
+}
+`, name, testAccCrlContent)
+}
+
+func TestAccCrlResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_crl.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCrlConfig("revoked"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "crl.name", "revoked"),
+					resource.TestCheckResourceAttr(name, "crl.issuer", "CN=Mock CA"),
+					resource.TestCheckResourceAttr(name, "crl.active", "true"),
+					resource.TestCheckResourceAttrSet(name, "crl.next_update"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccCrlConfig("revoked-v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "crl.name", "revoked-v2"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccCrlConfig("revoked-v2"),
+				Taint:  []string{name},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccCrlConfig("revoked-v2"), func() string {
+				return "/workspace/tls/crls/" + id
+			}),
+		},
+	})
+}
@@ -2,13 +2,13 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,6 +36,7 @@ type customPageResourceModel struct {
 	CustomPage  *customPageResourceResults `tfsdk:"custom_page"`
 	ID          types.String               `tfsdk:"id"`
 	LastUpdated types.String               `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value             `tfsdk:"timeouts"`
 }
 
 type customPageResourceResults struct {
@@ -78,7 +79,7 @@ func (r *customPageResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_custom_page"
 }
 
-func (r *customPageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *customPageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates a Custom Page resource.",
 		Attributes: map[string]schema.Attribute{
@@ -196,6 +197,14 @@ func (r *customPageResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -214,6 +223,14 @@ func (r *customPageResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_custom_page to be created")
+	defer cancel()
+
 	// Build the request.
 	customPageRequest := azionapi.CustomPageRequest{
 		Name: plan.CustomPage.Name.ValueString(),
@@ -262,37 +279,11 @@ func (r *customPageResource) Create(ctx context.Context, req resource.CreateRequ
 
 	createCustomPage, response, err := r.client.api.CustomPagesAPI.CreateCustomPage(ctx).CustomPageRequest(customPageRequest).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			createCustomPage, response, err = utils.RetryOn429(func() (*azionapi.CustomPageResponse, *http.Response, error) {
-				return r.client.api.CustomPagesAPI.CreateCustomPage(ctx).CustomPageRequest(customPageRequest).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("custom_page"), err, response)
+		return
 	}
 
 	// Populate the state from the response.
@@ -358,6 +349,14 @@ func (r *customPageResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_custom_page to be read")
+	defer cancel()
+
 	var customPageId int64
 	var err error
 	if state.CustomPage != nil {
@@ -375,41 +374,15 @@ func (r *customPageResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	getCustomPage, response, err := r.client.api.CustomPagesAPI.RetrieveCustomPage(ctx, customPageId).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			getCustomPage, response, err = utils.RetryOn429(func() (*azionapi.CustomPageResponse, *http.Response, error) {
-				return r.client.api.CustomPagesAPI.RetrieveCustomPage(ctx, customPageId).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("custom_page"), err, response)
+		return
 	}
 
 	state.CustomPage = &customPageResourceResults{
@@ -473,6 +446,14 @@ func (r *customPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_custom_page to be updated")
+	defer cancel()
+
 	var state customPageResourceModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -544,37 +525,11 @@ func (r *customPageResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Custom Pages API uses PUT for full update.
 	updateCustomPage, response, err := r.client.api.CustomPagesAPI.UpdateCustomPage(ctx, customPageId).CustomPageRequest(customPageRequest).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			updateCustomPage, response, err = utils.RetryOn429(func() (*azionapi.CustomPageResponse, *http.Response, error) {
-				return r.client.api.CustomPagesAPI.UpdateCustomPage(ctx, customPageId).CustomPageRequest(customPageRequest).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("custom_page"), err, response)
+		return
 	}
 
 	// Populate the state from the response.
@@ -640,6 +595,14 @@ func (r *customPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_custom_page to be deleted")
+	defer cancel()
+
 	var customPageId int64
 	var err error
 	if state.CustomPage != nil {
@@ -655,28 +618,20 @@ func (r *customPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 		}
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.CustomPagesAPI.DeleteCustomPage(ctx, customPageId).Execute() //nolint
 	}, 5) // Maximum 5 retries
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("custom_page"), err, response)
 		return
 	}
 }
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccCustomPageConfig(name, uri string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_connector" "test" {
+  connector = {
+    name   = "errors"
+    type   = "storage"
+    active = true
+    storage_attributes = {
+      bucket = "errors"
+    }
+  }
+}
+
+resource "azion_custom_page" "test" {
+  custom_page = {
+    name   = %q
+    active = true
+    pages = [
+      {
+        entry = {
+          code = "404"
+          page = {
+            type = "application"
+            attributes = {
+              connector = azion_connector.test.connector.id
+              ttl       = 60
+              uri       = %q
+            }
+          }
+        }
+      }
+    ]
+  }
+}
+`, name, uri)
+}
+
+func TestAccCustomPageResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_custom_page.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomPageConfig("errors", "/errors/404.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "custom_page.name", "errors"),
+					resource.TestCheckResourceAttr(name, "custom_page.pages.0.entry.code", "404"),
+					resource.TestCheckResourceAttr(name, "custom_page.pages.0.entry.page.attributes.uri", "/errors/404.html"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccCustomPageConfig("errors-v2", "/errors/not-found.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "custom_page.name", "errors-v2"),
+					resource.TestCheckResourceAttr(name, "custom_page.pages.0.entry.page.attributes.uri", "/errors/not-found.html"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccCustomPageConfig("errors-v2", "/errors/not-found.html"),
+				Taint:  []string{name},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccCustomPageConfig("errors-v2", "/errors/not-found.html"), func() string {
+				return "/workspace/custom_pages/" + id
+			}),
+		},
+	})
+}
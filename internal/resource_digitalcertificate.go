@@ -3,12 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -40,6 +40,7 @@ type certificateResourceModel struct {
 	Results       *certificateResultsModel `tfsdk:"results"`
 	ID            types.String             `tfsdk:"id"`
 	LastUpdated   types.String             `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value           `tfsdk:"timeouts"`
 }
 
 // certificateResultsModel represents the certificate data in Terraform state.
@@ -77,7 +78,7 @@ func (r *certificateResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema for digital certificate.
-func (r *certificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *certificateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Provides a digital certificate resource. This resource allows you to create, update, and delete digital certificates.\n\n" +
 			"~> **Note about private_key and certificate_content:**\n" +
@@ -192,6 +193,14 @@ func (r *certificateResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -210,6 +219,14 @@ func (r *certificateResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_digital_certificate to be created")
+	defer cancel()
+
 	// Build the certificate request for V4 API.
 	certificateRequest := azionapi.Certificate{
 		Name:        plan.Results.Name.ValueString(),
@@ -220,37 +237,11 @@ func (r *certificateResource) Create(ctx context.Context, req resource.CreateReq
 	// Call the V4 API.
 	certificateResponse, response, err := r.client.api.DigitalCertificatesCertificatesAPI.CreateCertificate(ctx).Certificate(certificateRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			certificateResponse, response, err = utils.RetryOn429(func() (*azionapi.CertificateResponse, *http.Response, error) {
-				return r.client.api.DigitalCertificatesCertificatesAPI.CreateCertificate(ctx).Certificate(certificateRequest).Execute()
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -279,8 +270,21 @@ func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	// Get the certificate ID from state.
-	certificateID, err := parseCertificateID(state.ID, state.Results.ID)
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_digital_certificate to be read")
+	defer cancel()
+
+	// Get the certificate ID from state. On import only the top-level id is set.
+	importing := state.Results == nil
+	resultsID := types.Int64Null()
+	if !importing {
+		resultsID = state.Results.ID
+	}
+	certificateID, err := parseCertificateID(state.ID, resultsID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Value Conversion error",
@@ -292,41 +296,15 @@ func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest
 	// Call the V4 API.
 	certificateResponse, response, err := r.client.api.DigitalCertificatesCertificatesAPI.RetrieveCertificate(ctx, certificateID).Execute()
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			certificateResponse, response, err = utils.RetryOn429(func() (*azionapi.CertificateResponse, *http.Response, error) {
-				return r.client.api.DigitalCertificatesCertificatesAPI.RetrieveCertificate(ctx, certificateID).Execute()
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -334,12 +312,20 @@ func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Preserve the private key and certificate content from state since API doesn't return them.
-	privateKey := state.Results.PrivateKey.ValueString()
-	certificateContent := state.Results.CertificateContent.ValueString()
+	var privateKey, certificateContent string
+	if !importing {
+		privateKey = state.Results.PrivateKey.ValueString()
+		certificateContent = state.Results.CertificateContent.ValueString()
+	}
 
 	// Populate the state from the API response.
 	cert := certificateResponse.GetData()
 	state.Results = populateCertificateResultsFromAPI(ctx, cert, certificateContent, privateKey)
+	if importing {
+		// Imported certificates take both from the configuration on the next apply.
+		state.Results.CertificateContent = types.StringNull()
+		state.Results.PrivateKey = types.StringNull()
+	}
 	state.SchemaVersion = types.Int64Value(1)
 
 	diags = resp.State.Set(ctx, &state)
@@ -357,6 +343,14 @@ func (r *certificateResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_digital_certificate to be updated")
+	defer cancel()
+
 	var state certificateResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -384,37 +378,11 @@ func (r *certificateResource) Update(ctx context.Context, req resource.UpdateReq
 	// Call the V4 API (using PUT for full update).
 	certificateResponse, response, err := r.client.api.DigitalCertificatesCertificatesAPI.UpdateCertificate(ctx, certificateID).Certificate(certificateRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			certificateResponse, response, err = utils.RetryOn429(func() (*azionapi.CertificateResponse, *http.Response, error) {
-				return r.client.api.DigitalCertificatesCertificatesAPI.UpdateCertificate(ctx, certificateID).Certificate(certificateRequest).Execute()
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
 	} else {
 		if response != nil {
 			defer response.Body.Close()
@@ -443,6 +411,14 @@ func (r *certificateResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_digital_certificate to be deleted")
+	defer cancel()
+
 	// Get the certificate ID from state.
 	certificateID, err := parseCertificateID(state.ID, state.Results.ID)
 	if err != nil {
@@ -454,28 +430,20 @@ func (r *certificateResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	// Call the V4 API to delete the certificate.
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.DigitalCertificatesCertificatesAPI.DeleteCertificate(ctx, certificateID).Execute()
 	}, 5) // Maximum 5 retries
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
 		return
 	}
 }
@@ -10,6 +10,7 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,10 +35,12 @@ type dnssecResource struct {
 }
 
 type dnssecResourceModel struct {
-	ZoneId        types.String `tfsdk:"zone_id"`
-	SchemaVersion types.Int64  `tfsdk:"schema_version"`
-	Dnssec        *dnssecModel `tfsdk:"dnssec"`
-	LastUpdated   types.String `tfsdk:"last_updated"`
+	ID            types.String   `tfsdk:"id"`
+	ZoneId        types.String   `tfsdk:"zone_id"`
+	SchemaVersion types.Int64    `tfsdk:"schema_version"`
+	Dnssec        *dnssecModel   `tfsdk:"dnssec"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 type dnssecModel struct {
@@ -48,14 +51,22 @@ func (r *dnssecResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_intelligent_dns_dnssec"
 }
 
-func (r *dnssecResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *dnssecResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the resource, the same as `zone_id`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"zone_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The zone identifier to target for the resource.",
+				Description: "The zone identifier to target for the resource. Changing it replaces the resource.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"schema_version": schema.Int64Attribute{
@@ -76,6 +87,14 @@ func (r *dnssecResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -95,6 +114,14 @@ func (r *dnssecResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_intelligent_dns_dnssec to be created")
+	defer cancel()
+
 	zoneId, err := strconv.ParseInt(plan.ZoneId.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -108,41 +135,14 @@ func (r *dnssecResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	_, response, err := r.client.api.DNSDNSSECAPI.UpdateDnssec(ctx, zoneId).DNSSECRequest(*dnssecReq).Execute()
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		// Check if the error is due to JSON unmarshaling (unknown field) but HTTP request was successful
 		if response != nil && response.StatusCode >= 200 && response.StatusCode < 300 {
 			// HTTP request was successful, proceed to parse response manually
-		} else if response != nil && response.StatusCode == 429 {
-			_, response, err = utils.RetryOn429(func() (*azionapi.DNSSECResponse, *http.Response, error) {
-				return r.client.api.DNSDNSSECAPI.UpdateDnssec(ctx, zoneId).DNSSECRequest(*dnssecReq).Execute()
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				// Check again if it's a successful response after retry
-				if response == nil || response.StatusCode < 200 || response.StatusCode >= 300 {
-					resp.Diagnostics.AddError(
-						err.Error(),
-						"API request failed after too many retries",
-					)
-					return
-				}
-			}
 		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+			utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("dnssec"), err, response)
 			return
 		}
 	}
@@ -170,6 +170,7 @@ func (r *dnssecResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	plan.ID = plan.ZoneId
 	plan.Dnssec = &dnssecModel{
 		IsEnabled: types.BoolValue(dnssecResp.Data.Enabled),
 	}
@@ -191,6 +192,15 @@ func (r *dnssecResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_intelligent_dns_dnssec to be read")
+	defer cancel()
+
 	zoneId, err := strconv.ParseInt(state.ZoneId.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -202,44 +212,17 @@ func (r *dnssecResource) Read(
 
 	_, response, err := r.client.api.DNSDNSSECAPI.RetrieveDnssec(ctx, zoneId).Execute()
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		// Check if the error is due to JSON unmarshaling (unknown field) but HTTP request was successful
 		if response != nil && response.StatusCode >= 200 && response.StatusCode < 300 {
 			// HTTP request was successful, proceed to parse response manually
 		} else if response != nil && response.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
-		} else if response != nil && response.StatusCode == 429 {
-			_, response, err = utils.RetryOn429(func() (*azionapi.DNSSECResponse, *http.Response, error) {
-				return r.client.api.DNSDNSSECAPI.RetrieveDnssec(ctx, zoneId).Execute()
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				// Check again if it's a successful response after retry
-				if response == nil || response.StatusCode < 200 || response.StatusCode >= 300 {
-					resp.Diagnostics.AddError(
-						err.Error(),
-						"API request failed after too many retries",
-					)
-					return
-				}
-			}
 		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+			utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("dnssec"), err, response)
 			return
 		}
 	}
@@ -267,6 +250,7 @@ func (r *dnssecResource) Read(
 		return
 	}
 
+	state.ID = state.ZoneId
 	state.Dnssec = &dnssecModel{
 		IsEnabled: types.BoolValue(dnssecResp.Data.Enabled),
 	}
@@ -285,6 +269,14 @@ func (r *dnssecResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_intelligent_dns_dnssec to be updated")
+	defer cancel()
+
 	zoneId, err := strconv.ParseInt(plan.ZoneId.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -298,41 +290,14 @@ func (r *dnssecResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	_, response, err := r.client.api.DNSDNSSECAPI.UpdateDnssec(ctx, zoneId).DNSSECRequest(*dnssecReq).Execute()
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		// Check if the error is due to JSON unmarshaling (unknown field) but HTTP request was successful
 		if response != nil && response.StatusCode >= 200 && response.StatusCode < 300 {
 			// HTTP request was successful, proceed to parse response manually
-		} else if response != nil && response.StatusCode == 429 {
-			_, response, err = utils.RetryOn429(func() (*azionapi.DNSSECResponse, *http.Response, error) {
-				return r.client.api.DNSDNSSECAPI.UpdateDnssec(ctx, zoneId).DNSSECRequest(*dnssecReq).Execute()
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				// Check again if it's a successful response after retry
-				if response == nil || response.StatusCode < 200 || response.StatusCode >= 300 {
-					resp.Diagnostics.AddError(
-						err.Error(),
-						"API request failed after too many retries",
-					)
-					return
-				}
-			}
 		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+			utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("dnssec"), err, response)
 			return
 		}
 	}
@@ -360,6 +325,7 @@ func (r *dnssecResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	plan.ID = plan.ZoneId
 	plan.Dnssec = &dnssecModel{
 		IsEnabled: types.BoolValue(dnssecResp.Data.Enabled),
 	}
@@ -380,6 +346,14 @@ func (r *dnssecResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_intelligent_dns_dnssec to be deleted")
+	defer cancel()
+
 	zoneId, err := strconv.ParseInt(state.ZoneId.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -391,13 +365,16 @@ func (r *dnssecResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	dnssecReq := azionapi.NewDNSSECRequest(false)
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DNSSECResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DNSSECResponse, *http.Response, error) {
 		return r.client.api.DNSDNSSECAPI.UpdateDnssec(ctx, zoneId).DNSSECRequest(*dnssecReq).Execute()
 	}, 5) // Maximum 5 retries
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		// HTTP request succeeded but the body failed to unmarshal (unknown field);
 		// the delete doesn't need the response body, so treat it as success.
 		if response != nil && response.StatusCode >= 200 && response.StatusCode < 300 {
@@ -406,18 +383,7 @@ func (r *dnssecResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		if response != nil && response.StatusCode == http.StatusNotFound {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
-		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("dnssec"), err, response)
 		return
 	}
 }
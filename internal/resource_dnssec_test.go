@@ -1,73 +1,77 @@
 package provider
 
-//func TestAccResourceDnsSec(t *testing.T) {
-//	resourceName := "azion_intelligent_dns_dnssec.examples"
-//	resource.ParallelTest(t, resource.TestCase{
-//		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-//		CheckDestroy:             testAccDNSSecResourceDestroy,
-//		Steps: []resource.TestStep{
-//			{
-//				Config: testAccDNSSecResourceConfig(),
-//				Check: resource.ComposeTestCheckFunc(
-//					resource.TestCheckResourceAttr(resourceName, "schema_version", "3"),
-//					resource.TestCheckResourceAttr(resourceName, "id", "2595"),
-//					resource.TestCheckResourceAttr(resourceName, "dns_sec.is_enabled", "true"),
-//					resource.TestCheckResourceAttr(resourceName, "dns_sec.status", "ready"),
-//					resource.TestCheckResourceAttr(resourceName, "dns_sec.delegation_signer.digesttype.id", "2"),
-//					resource.TestCheckResourceAttr(resourceName, "dns_sec.delegation_signer.digesttype.slug", "SHA256"),
-//					resource.TestCheckResourceAttr(resourceName, "dns_sec.delegation_signer.algorithmtype.id", "13"),
-//					resource.TestCheckResourceAttr(resourceName, "dns_sec.delegation_signer.algorithmtype.slug", "ECDSAP256SHA256"),
-//					resource.TestCheckResourceAttr(resourceName, "dns_sec.delegation_signer.digest", "35dbd2f5cd43d191d6f7c61f9c8d79149254186761a188b667f5ca78d0a3cc27"),
-//					resource.TestCheckResourceAttr(resourceName, "dns_sec.delegation_signer.keytag", "32597"),
-//				),
-//			},
-//			{
-//				Config: testAccDNSSecResourceConfigUpdate(),
-//				Check: resource.ComposeTestCheckFunc(
-//					resource.TestCheckResourceAttr(resourceName, "schema_version", "3"),
-//					resource.TestCheckResourceAttr(resourceName, "id", "2595"),
-//					resource.TestCheckResourceAttr(resourceName, "dns_sec.is_enabled", "false"),
-//					resource.TestCheckResourceAttr(resourceName, "dns_sec.status", "ready"),
-//				),
-//			},
-//			{
-//				ResourceName:            resourceName,
-//				ImportState:             true,
-//				ImportStateVerify:       true,
-//				ImportStateVerifyIgnore: []string{"last_updated", "schema_version"},
-//			},
-//		},
-//	})
-//}
-//
-//func testAccDNSSecResourceDestroy(s *terraform.State) error {
-//	return nil
-//}
-//
-//func testAccDNSSecResourceConfig() string {
-//	return `
-//provider "azion" {
-//  api_token  = "token"
-//}
-//resource "azion_intelligent_dns_dnssec" "examples" {
-//  id = "2595"
-//  dns_sec = {
-//      is_enabled = true
-//    }
-//}
-//`
-//}
-//
-//func testAccDNSSecResourceConfigUpdate() string {
-//	return `
-//provider "azion" {
-//  api_token  = "token"
-//}
-//resource "azion_intelligent_dns_dnssec" "examples" {
-//  id = "2595"
-//  dns_sec = {
-//      is_enabled = false
-//    }
-//}
-//`
-//}
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccDnssecConfig(zone string, enabled bool) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_intelligent_dns_zone" "first" {
+  zone = {
+    name   = "first"
+    domain = "example.com"
+    active = true
+  }
+}
+
+resource "azion_intelligent_dns_zone" "second" {
+  zone = {
+    name   = "second"
+    domain = "example.org"
+    active = true
+  }
+}
+
+resource "azion_intelligent_dns_dnssec" "test" {
+  zone_id = azion_intelligent_dns_zone.%s.id
+  dnssec = {
+    is_enabled = %t
+  }
+}
+`, zone, enabled)
+}
+
+func TestAccDnssecResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_intelligent_dns_dnssec.test"
+	var zoneID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnssecConfig("first", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "zone_id", "azion_intelligent_dns_zone.first", "id"),
+					resource.TestCheckResourceAttrPair(name, "id", "azion_intelligent_dns_zone.first", "id"),
+					resource.TestCheckResourceAttr(name, "dnssec.is_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccDnssecConfig("first", false),
+				Check:  resource.TestCheckResourceAttr(name, "dnssec.is_enabled", "false"),
+			},
+			{
+				Config: testAccDnssecConfig("second", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "zone_id", "azion_intelligent_dns_zone.second", "id"),
+					resource.TestCheckResourceAttr(name, "dnssec.is_enabled", "true"),
+					testAccCaptureAttr(name, "zone_id", &zoneID),
+				),
+			},
+			// The DNSSEC settings go away with their zone.
+			testAccDeletedStep(t, server, testAccDnssecConfig("second", true), func() string {
+				return "/workspace/dns/zones/" + zoneID
+			}),
+		},
+	})
+}
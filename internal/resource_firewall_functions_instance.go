@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -10,10 +9,12 @@ import (
 
 	sdk "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -41,6 +42,7 @@ type FirewallFunctionInstanceResourceModel struct {
 	ID          types.String                         `tfsdk:"id"`
 	FirewallID  types.Int64                          `tfsdk:"firewall_id"`
 	LastUpdated types.String                         `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value                       `tfsdk:"timeouts"`
 }
 
 type FirewallFunctionInstanceResourceData struct {
@@ -58,7 +60,7 @@ func (r *FirewallFunctionsInstanceResource) Metadata(_ context.Context, req reso
 	resp.TypeName = req.ProviderTypeName + "_firewall_functions_instance"
 }
 
-func (r *FirewallFunctionsInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *FirewallFunctionsInstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -68,8 +70,11 @@ func (r *FirewallFunctionsInstanceResource) Schema(_ context.Context, _ resource
 				},
 			},
 			"firewall_id": schema.Int64Attribute{
-				Description: "The firewall identifier.",
+				Description: "The firewall identifier. Changing it replaces the function instance.",
 				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"state": schema.StringAttribute{
 				Description: "State of the function instance.",
@@ -121,6 +126,14 @@ func (r *FirewallFunctionsInstanceResource) Schema(_ context.Context, _ resource
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -140,6 +153,14 @@ func (r *FirewallFunctionsInstanceResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_firewall_functions_instance to be created")
+	defer cancel()
+
 	diagsFirewallID := req.Config.GetAttribute(ctx, path.Root("firewall_id"), &firewallID)
 	resp.Diagnostics.Append(diagsFirewallID...)
 	if resp.Diagnostics.HasError() {
@@ -156,14 +177,7 @@ func (r *FirewallFunctionsInstanceResource) Create(ctx context.Context, req reso
 		}
 	}
 
-	planJsonArgs, err := utils.UnmarshallJsonArgsFirewall(argsStr)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			err.Error(),
-			"failed to unmarshal json args from plan",
-		)
-		return
-	}
+	planJsonArgs := utils.FunctionArgs(argsStr)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -181,40 +195,11 @@ func (r *FirewallFunctionsInstanceResource) Create(ctx context.Context, req reso
 		FirewallFunctionInstanceRequest(functionInstanceRequest).
 		Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			functionInstanceResponse, response, err = utils.RetryOn429(func() (*sdk.FirewallFunctionInstanceResponse, *http.Response, error) {
-				return r.client.api.FirewallsFunctionAPI.
-					CreateFirewallFunction(ctx, firewallID.ValueInt64()).
-					FirewallFunctionInstanceRequest(functionInstanceRequest).
-					Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
+		return
 	}
 
 	jsonArgsStr, err := utils.ConvertInterfaceToString(functionInstanceResponse.Data.GetArgs())
@@ -258,6 +243,14 @@ func (r *FirewallFunctionsInstanceResource) Read(ctx context.Context, req resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_firewall_functions_instance to be read")
+	defer cancel()
 	var firewallID int64
 	var functionInstanceID int64
 	valueFromCmd := strings.Split(state.ID.ValueString(), "/")
@@ -281,43 +274,15 @@ func (r *FirewallFunctionsInstanceResource) Read(ctx context.Context, req resour
 		api.FirewallsFunctionAPI.
 		RetrieveFirewallFunction(ctx, firewallID, functionInstanceID).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			functionInstanceResponse, response, err = utils.RetryOn429(func() (*sdk.FirewallFunctionInstanceResponse, *http.Response, error) {
-				return r.client.
-					api.FirewallsFunctionAPI.
-					RetrieveFirewallFunction(ctx, firewallID, functionInstanceID).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
+		return
 	}
 
 	jsonArgsStr, err := utils.ConvertInterfaceToString(functionInstanceResponse.Data.GetArgs())
@@ -344,6 +309,7 @@ func (r *FirewallFunctionsInstanceResource) Read(ctx context.Context, req resour
 			Active:       types.BoolValue(functionInstanceResponse.Data.GetActive()),
 			CreatedAt:    types.StringValue(functionInstanceResponse.Data.GetCreatedAt().Format(time.RFC3339)),
 		},
+		Timeouts: state.Timeouts,
 	}
 
 	diags = resp.State.Set(ctx, &readState)
@@ -363,6 +329,14 @@ func (r *FirewallFunctionsInstanceResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_firewall_functions_instance to be updated")
+	defer cancel()
+
 	var state FirewallFunctionInstanceResourceModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -386,14 +360,7 @@ func (r *FirewallFunctionsInstanceResource) Update(ctx context.Context, req reso
 		}
 	}
 
-	requestJsonArgsStr, err := utils.UnmarshallJsonArgsFirewall(argsStr)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			err.Error(),
-			"failed to unmarshal json args from plan",
-		)
-		return
-	}
+	requestJsonArgsStr := utils.FunctionArgs(argsStr)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -411,40 +378,11 @@ func (r *FirewallFunctionsInstanceResource) Update(ctx context.Context, req reso
 		PatchedFirewallFunctionInstanceRequest(patchRequest).
 		Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			updateResponse, response, err = utils.RetryOn429(func() (*sdk.FirewallFunctionInstanceResponse, *http.Response, error) {
-				return r.client.api.FirewallsFunctionAPI.
-					PartialUpdateFirewallFunction(ctx, firewallID.ValueInt64(), functionInstanceID.ValueInt64()).
-					PatchedFirewallFunctionInstanceRequest(patchRequest).
-					Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
+		return
 	}
 
 	jsonArgsStr, err := utils.ConvertInterfaceToString(updateResponse.Data.GetArgs())
@@ -489,6 +427,14 @@ func (r *FirewallFunctionsInstanceResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_firewall_functions_instance to be deleted")
+	defer cancel()
+
 	if state.Data.ID.IsNull() {
 		resp.Diagnostics.AddError(
 			"Function Instance id error ",
@@ -505,7 +451,7 @@ func (r *FirewallFunctionsInstanceResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (*sdk.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*sdk.DeleteResponse, *http.Response, error) {
 		return r.client.api.FirewallsFunctionAPI.
 			DeleteFirewallFunction(ctx, state.FirewallID.ValueInt64(), state.Data.ID.ValueInt64()).
 			Execute()
@@ -514,25 +460,49 @@ func (r *FirewallFunctionsInstanceResource) Delete(ctx context.Context, req reso
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
 		return
 	}
 }
 
 func (r *FirewallFunctionsInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import format: "firewallID/instanceID"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import format",
+			"Expected format: firewallID/instanceID",
+		)
+		return
+	}
+
+	firewallID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid firewall ID", err.Error())
+		return
+	}
+
+	instanceID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid instance ID", err.Error())
+		return
+	}
+
+	state := FirewallFunctionInstanceResourceModel{
+		ID:         types.StringValue(strconv.FormatInt(instanceID, 10)),
+		FirewallID: types.Int64Value(firewallID),
+		Data: FirewallFunctionInstanceResourceData{
+			ID: types.Int64Value(instanceID),
+		},
+		Timeouts: utils.NullTimeouts(),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 }
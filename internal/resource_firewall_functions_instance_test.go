@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccFirewallFunctionInstanceConfig(firewall, name, args string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_firewall_main_setting" "first" {
+  data = {
+    name = "first"
+    modules = {
+      functions = {
+        enabled = true
+      }
+    }
+  }
+}
+
+resource "azion_firewall_main_setting" "second" {
+  data = {
+    name = "second"
+    modules = {
+      functions = {
+        enabled = true
+      }
+    }
+  }
+}
+
+resource "azion_function" "test" {
+  function = {
+    name                  = "guard"
+    code                  = "addEventListener('firewall', () => {})"
+    execution_environment = "firewall"
+  }
+}
+
+resource "azion_firewall_functions_instance" "test" {
+  firewall_id = azion_firewall_main_setting.%s.data.id
+  data = {
+    name     = %q
+    function = azion_function.test.function.id
+    args     = %q
+  }
+}
+`, firewall, name, args)
+}
+
+func TestAccFirewallFunctionsInstanceResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_firewall_functions_instance.test"
+	var firewallID, instanceID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallFunctionInstanceConfig("first", "guard", `{"key":"value"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "data.name", "guard"),
+					resource.TestCheckResourceAttrPair(name, "data.function", "azion_function.test", "function.id"),
+					resource.TestCheckResourceAttr(name, "data.args", `{"key":"value"}`),
+					testAccCaptureAttr(name, "id", &instanceID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID(name, "firewall_id", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "state"},
+			},
+			{
+				Config: testAccFirewallFunctionInstanceConfig("first", "guard-v2", `{"key":"other"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "data.name", "guard-v2"),
+					resource.TestCheckResourceAttr(name, "data.args", `{"key":"other"}`),
+					testAccCheckAttrSame(name, "id", &instanceID),
+				),
+			},
+			{
+				Config: testAccFirewallFunctionInstanceConfig("second", "guard-v2", `{"key":"other"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "firewall_id", "azion_firewall_main_setting.second", "data.id"),
+					testAccCheckAttrChanged(name, "id", &instanceID),
+					testAccCaptureAttr(name, "firewall_id", &firewallID),
+					testAccCaptureAttr(name, "id", &instanceID),
+				),
+			},
+			testAccDeletedStep(t, server, testAccFirewallFunctionInstanceConfig("second", "guard-v2", `{"key":"other"}`), func() string {
+				return "/workspace/firewalls/" + firewallID + "/functions/" + instanceID
+			}),
+		},
+	})
+}
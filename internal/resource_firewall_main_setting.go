@@ -2,16 +2,17 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	sdk "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -36,6 +37,7 @@ type FirewallResourceModel struct {
 	Firewall    *FirewallResourceResults `tfsdk:"data"`
 	ID          types.String             `tfsdk:"id"`
 	LastUpdated types.String             `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value           `tfsdk:"timeouts"`
 }
 
 type FirewallResourceModules struct {
@@ -48,6 +50,7 @@ type FirewallResourceModules struct {
 type FirewallResourceResults struct {
 	ID             types.Int64              `tfsdk:"id"`
 	Name           types.String             `tfsdk:"name"`
+	FullName       types.String             `tfsdk:"full_name"`
 	Modules        *FirewallResourceModules `tfsdk:"modules"`
 	Debug          types.Bool               `tfsdk:"debug"`
 	Active         types.Bool               `tfsdk:"active"`
@@ -65,7 +68,7 @@ func (r *firewallResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_firewall_main_setting"
 }
 
-func (r *firewallResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *firewallResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -89,6 +92,10 @@ func (r *firewallResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						Description: "Name of the firewall rule set.",
 						Required:    true,
 					},
+					"full_name": schema.StringAttribute{
+						Description: "Name of the firewall in the API, which starts with the `default_name_prefix` of the provider.",
+						Computed:    true,
+					},
 					"modules": schema.SingleNestedAttribute{
 						Description: "Modules configuration for the firewall.",
 						Optional:    true,
@@ -139,11 +146,13 @@ func (r *firewallResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						Description: "Whether debug is enabled for the rule set.",
 						Optional:    true,
 						Computed:    true,
+						Default:     booldefault.StaticBool(false),
 					},
 					"active": schema.BoolAttribute{
 						Description: "Whether the firewall rule set is active.",
 						Optional:    true,
 						Computed:    true,
+						Default:     booldefault.StaticBool(true),
 					},
 					"last_editor": schema.StringAttribute{
 						Description: "Last editor of the firewall rule set.",
@@ -180,6 +189,14 @@ func (r *firewallResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -198,6 +215,14 @@ func (r *firewallResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_firewall_main_setting to be created")
+	defer cancel()
+
 	modules := sdk.FirewallModulesRequest{}
 	if plan.Firewall.Modules != nil {
 		if plan.Firewall.Modules.Functions != nil && !plan.Firewall.Modules.Functions.Enabled.IsNull() {
@@ -218,7 +243,7 @@ func (r *firewallResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	firewallRequest := sdk.FirewallRequest{
-		Name:    plan.Firewall.Name.ValueString(),
+		Name:    r.client.prefixName(plan.Firewall.Name.ValueString()),
 		Active:  plan.Firewall.Active.ValueBoolPointer(),
 		Debug:   plan.Firewall.Debug.ValueBoolPointer(),
 		Modules: &modules,
@@ -226,37 +251,11 @@ func (r *firewallResource) Create(ctx context.Context, req resource.CreateReques
 
 	firewallResponse, response, err := r.client.api.FirewallsAPI.CreateFirewall(ctx).FirewallRequest(firewallRequest).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			firewallResponse, response, err = utils.RetryOn429(func() (*sdk.FirewallResponse, *http.Response, error) {
-				return r.client.api.FirewallsAPI.CreateFirewall(ctx).FirewallRequest(firewallRequest).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
+		return
 	}
 
 	mods := firewallResponse.Data.GetModules()
@@ -295,7 +294,8 @@ func (r *firewallResource) Create(ctx context.Context, req resource.CreateReques
 
 	plan.Firewall = &FirewallResourceResults{
 		ID:             types.Int64Value(firewallResponse.Data.GetId()),
-		Name:           types.StringValue(firewallResponse.Data.GetName()),
+		Name:           types.StringValue(r.client.unprefixName(firewallResponse.Data.GetName())),
+		FullName:       types.StringValue(firewallResponse.Data.GetName()),
 		Modules:        responseModulesPtr,
 		Debug:          types.BoolValue(firewallResponse.Data.GetDebug()),
 		Active:         types.BoolValue(firewallResponse.Data.GetActive()),
@@ -326,6 +326,14 @@ func (r *firewallResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_firewall_main_setting to be read")
+	defer cancel()
 	var firewallID int64
 	if state.ID.IsNull() {
 		firewallID = state.Firewall.ID.ValueInt64()
@@ -341,41 +349,15 @@ func (r *firewallResource) Read(ctx context.Context, req resource.ReadRequest, r
 	firewallResponse, response, err := r.client.api.FirewallsAPI.
 		RetrieveFirewall(ctx, firewallID).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			firewallResponse, response, err = utils.RetryOn429(func() (*sdk.FirewallResponse, *http.Response, error) {
-				return r.client.api.FirewallsAPI.RetrieveFirewall(ctx, firewallID).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
+		return
 	}
 
 	// Preserve the prior state's Modules shape so unconfigured submodules
@@ -384,11 +366,12 @@ func (r *firewallResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// state is nil (import), populate every submodule the API returned so the
 	// imported state mirrors reality.
 	var priorModules *FirewallResourceModules
-	if state.Firewall != nil {
+	importing := state.Firewall == nil
+	if !importing {
 		priorModules = state.Firewall.Modules
 	}
 	var modulesResponsePtr *FirewallResourceModules
-	if firewallResponse.Data.Modules != nil {
+	if firewallResponse.Data.Modules != nil && (importing || priorModules != nil) {
 		modules := firewallResponse.Data.GetModules()
 		modulesResponse := FirewallResourceModules{}
 		if priorModules == nil || priorModules.DdosProtection != nil {
@@ -423,7 +406,8 @@ func (r *firewallResource) Read(ctx context.Context, req resource.ReadRequest, r
 		LastEditor:     types.StringValue(firewallResponse.Data.GetLastEditor()),
 		LastModified:   types.StringValue(firewallResponse.Data.GetLastModified().Format(time.RFC3339)),
 		CreatedAt:      types.StringValue(firewallResponse.Data.GetCreatedAt().Format(time.RFC3339)),
-		Name:           types.StringValue(firewallResponse.Data.GetName()),
+		Name:           types.StringValue(r.client.unprefixName(firewallResponse.Data.GetName())),
+		FullName:       types.StringValue(firewallResponse.Data.GetName()),
 		Active:         types.BoolValue(firewallResponse.Data.GetActive()),
 		Debug:          types.BoolValue(firewallResponse.Data.GetDebug()),
 		Modules:        modulesResponsePtr,
@@ -450,6 +434,14 @@ func (r *firewallResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_firewall_main_setting to be updated")
+	defer cancel()
+
 	var state FirewallResourceModel
 	diagsFirewall := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsFirewall...)
@@ -470,7 +462,7 @@ func (r *firewallResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	firewallRequest := sdk.PatchedFirewallRequest{
-		Name:   plan.Firewall.Name.ValueStringPointer(),
+		Name:   sdk.PtrString(r.client.prefixName(plan.Firewall.Name.ValueString())),
 		Active: plan.Firewall.Active.ValueBoolPointer(),
 		Debug:  plan.Firewall.Debug.ValueBoolPointer(),
 	}
@@ -497,37 +489,11 @@ func (r *firewallResource) Update(ctx context.Context, req resource.UpdateReques
 
 	firewallResponse, response, err := r.client.api.FirewallsAPI.PartialUpdateFirewall(ctx, firewallID).PatchedFirewallRequest(firewallRequest).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			firewallResponse, response, err = utils.RetryOn429(func() (*sdk.FirewallResponse, *http.Response, error) {
-				return r.client.api.FirewallsAPI.PartialUpdateFirewall(ctx, firewallID).PatchedFirewallRequest(firewallRequest).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
+		return
 	}
 
 	mods := firewallResponse.Data.GetModules()
@@ -570,7 +536,8 @@ func (r *firewallResource) Update(ctx context.Context, req resource.UpdateReques
 		LastEditor:     types.StringValue(firewallResponse.Data.GetLastEditor()),
 		LastModified:   types.StringValue(firewallResponse.Data.GetLastModified().Format(time.RFC3339)),
 		CreatedAt:      types.StringValue(firewallResponse.Data.GetCreatedAt().Format(time.RFC3339)),
-		Name:           types.StringValue(firewallResponse.Data.GetName()),
+		Name:           types.StringValue(r.client.unprefixName(firewallResponse.Data.GetName())),
+		FullName:       types.StringValue(firewallResponse.Data.GetName()),
 		Active:         types.BoolValue(firewallResponse.Data.GetActive()),
 		Debug:          types.BoolValue(firewallResponse.Data.GetDebug()),
 		ProductVersion: types.StringValue(firewallResponse.Data.GetProductVersion()),
@@ -599,6 +566,14 @@ func (r *firewallResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_firewall_main_setting to be deleted")
+	defer cancel()
+
 	var firewallID int64
 	if state.ID.IsNull() {
 		firewallID = state.Firewall.ID.ValueInt64()
@@ -611,28 +586,20 @@ func (r *firewallResource) Delete(ctx context.Context, req resource.DeleteReques
 		}
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (*sdk.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*sdk.DeleteResponse, *http.Response, error) {
 		return r.client.api.FirewallsAPI.DeleteFirewall(ctx, firewallID).Execute() //nolint
 	}, 5) // Maximum 5 retries
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("data"), err, response)
 		return
 	}
 }
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccFirewallConfig(name string, waf bool) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_firewall_main_setting" "test" {
+  data = {
+    name = %q
+    modules = {
+      ddos_protection = {
+        enabled = false
+      }
+      functions = {
+        enabled = true
+      }
+      network_protection = {
+        enabled = true
+      }
+      waf = {
+        enabled = %t
+      }
+    }
+  }
+}
+`, name, waf)
+}
+
+func TestAccFirewallMainSettingResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_firewall_main_setting.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallConfig("edge", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "data.name", "edge"),
+					resource.TestCheckResourceAttr(name, "data.active", "true"),
+					resource.TestCheckResourceAttr(name, "data.modules.waf.enabled", "true"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccFirewallConfig("edge-v2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "data.name", "edge-v2"),
+					resource.TestCheckResourceAttr(name, "data.modules.waf.enabled", "false"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccFirewallConfig("edge-v2", false),
+				Taint:  []string{name},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccFirewallConfig("edge-v2", false), func() string {
+				return "/workspace/firewalls/" + id
+			}),
+		},
+	})
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -11,9 +10,12 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -39,6 +41,7 @@ type FirewallRuleEngineResourceModel struct {
 	FirewallID  types.Int64                       `tfsdk:"firewall_id"`
 	LastUpdated types.String                      `tfsdk:"last_updated"`
 	Results     *FirewallRuleEngineResultResource `tfsdk:"results"`
+	Timeouts    timeouts.Value                    `tfsdk:"timeouts"`
 }
 
 type FirewallRuleEngineResultResource struct {
@@ -99,7 +102,7 @@ func (r *firewallRuleEngineResource) Metadata(_ context.Context, req resource.Me
 	resp.TypeName = req.ProviderTypeName + "_firewall_rule_engine"
 }
 
-func (r *firewallRuleEngineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *firewallRuleEngineResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -109,8 +112,11 @@ func (r *firewallRuleEngineResource) Schema(_ context.Context, _ resource.Schema
 				},
 			},
 			"firewall_id": schema.Int64Attribute{
-				Description: "The firewall identifier.",
+				Description: "The firewall identifier. Changing it replaces the rule.",
 				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update of the resource.",
@@ -257,6 +263,14 @@ func (r *firewallRuleEngineResource) Schema(_ context.Context, _ resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -276,6 +290,14 @@ func (r *firewallRuleEngineResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_firewall_rule_engine to be created")
+	defer cancel()
+
 	diagsFirewallID := req.Config.GetAttribute(ctx, path.Root("firewall_id"), &firewallID)
 	resp.Diagnostics.Append(diagsFirewallID...)
 	if resp.Diagnostics.HasError() {
@@ -311,29 +333,14 @@ func (r *firewallRuleEngineResource) Create(ctx context.Context, req resource.Cr
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			ruleResponse, response, err = utils.RetryOn429(func() (*azionapi.FirewallRuleResponse, *http.Response, error) {
-				return r.client.api.FirewallsRulesEngineAPI.
-					CreateFirewallRule(ctx, firewallID.ValueInt64()).
-					FirewallRuleRequest(*ruleRequest).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			handleFirewallRuleResourceAPIError(resp, response, err)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		handleFirewallRuleResourceAPIError(resp, response, err)
+		return
 	}
 
-	plan = buildFirewallRuleStateFromResponse(ruleResponse.Data, firewallID)
+	plan = buildFirewallRuleStateFromResponse(ruleResponse.Data, firewallID, plan.Timeouts)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -350,6 +357,14 @@ func (r *firewallRuleEngineResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_firewall_rule_engine to be read")
+	defer cancel()
+
 	var firewallID int64
 	var ruleID int64
 	valueFromCmd := strings.Split(state.ID.ValueString(), "/")
@@ -382,32 +397,18 @@ func (r *firewallRuleEngineResource) Read(ctx context.Context, req resource.Read
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			ruleResponse, response, err = utils.RetryOn429(func() (*azionapi.FirewallRuleResponse, *http.Response, error) {
-				return r.client.api.FirewallsRulesEngineAPI.
-					RetrieveFirewallRule(ctx, firewallID, ruleID).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			handleFirewallRuleResourceAPIError(resp, response, err)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		handleFirewallRuleResourceAPIError(resp, response, err)
+		return
 	}
 
-	state = buildFirewallRuleStateFromResponse(ruleResponse.Data, types.Int64Value(firewallID))
+	state = buildFirewallRuleStateFromResponse(ruleResponse.Data, types.Int64Value(firewallID), state.Timeouts)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -426,6 +427,14 @@ func (r *firewallRuleEngineResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_firewall_rule_engine to be updated")
+	defer cancel()
+
 	var state FirewallRuleEngineResourceModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -474,29 +483,14 @@ func (r *firewallRuleEngineResource) Update(ctx context.Context, req resource.Up
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			ruleResponse, response, err = utils.RetryOn429(func() (*azionapi.FirewallRuleResponse, *http.Response, error) {
-				return r.client.api.FirewallsRulesEngineAPI.
-					UpdateFirewallRule(ctx, firewallID.ValueInt64(), ruleID.ValueInt64()).
-					FirewallRuleRequest(*ruleRequest).
-					Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			handleFirewallRuleResourceAPIError(resp, response, err)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		handleFirewallRuleResourceAPIError(resp, response, err)
+		return
 	}
 
-	plan = buildFirewallRuleStateFromResponse(ruleResponse.Data, firewallID)
+	plan = buildFirewallRuleStateFromResponse(ruleResponse.Data, firewallID, plan.Timeouts)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -513,6 +507,14 @@ func (r *firewallRuleEngineResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_firewall_rule_engine to be deleted")
+	defer cancel()
+
 	if state.FirewallID.IsNull() {
 		resp.Diagnostics.AddError(
 			"Firewall ID error",
@@ -529,7 +531,7 @@ func (r *firewallRuleEngineResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (interface{}, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (interface{}, *http.Response, error) {
 		_, httpResp, e := r.client.api.FirewallsRulesEngineAPI.
 			DeleteFirewallRule(ctx, state.FirewallID.ValueInt64(), state.Results.ID.ValueInt64()).
 			Execute()
@@ -539,7 +541,10 @@ func (r *firewallRuleEngineResource) Delete(ctx context.Context, req resource.De
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode != http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		if response == nil || response.StatusCode != http.StatusNotFound {
 			handleFirewallRuleResourceAPIError(resp, response, err)
 			return
 		}
@@ -580,6 +585,7 @@ func (r *firewallRuleEngineResource) ImportState(ctx context.Context, req resour
 		ID: types.Int64Value(ruleID),
 	}
 	state.ID = types.StringValue(fmt.Sprintf("%d/%d", firewallID, ruleID))
+	state.Timeouts = utils.NullTimeouts()
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -691,13 +697,14 @@ func buildFirewallBehaviorsRequest(behaviors []FirewallBehaviorWrapperResourceMo
 	return result
 }
 
-func buildFirewallRuleStateFromResponse(rule azionapi.FirewallRule, firewallID types.Int64) FirewallRuleEngineResourceModel {
+func buildFirewallRuleStateFromResponse(rule azionapi.FirewallRule, firewallID types.Int64, timeoutsValue timeouts.Value) FirewallRuleEngineResourceModel {
 	result := transformFirewallRuleToResultModel(rule)
 	return FirewallRuleEngineResourceModel{
 		FirewallID:  firewallID,
 		ID:          types.StringValue(fmt.Sprintf("%d/%d", firewallID.ValueInt64(), result.ID.ValueInt64())),
 		LastUpdated: types.StringValue(time.Now().Format(time.RFC850)),
 		Results:     result,
+		Timeouts:    timeoutsValue,
 	}
 }
 
@@ -822,42 +829,18 @@ func transformFirewallBehaviorObjectAttrs(attrs azionapi.FirewallBehaviorObjectA
 }
 
 func handleFirewallRuleResourceAPIError(resp interface{}, response *http.Response, err error) {
+	var diags *diag.Diagnostics
 	switch r := resp.(type) {
 	case *resource.CreateResponse:
-		if response != nil && response.StatusCode == 429 {
-			r.Diagnostics.AddError(err.Error(), "Rate limited. Please retry.")
-		} else if response != nil {
-			bodyBytes, _ := io.ReadAll(response.Body)
-			r.Diagnostics.AddError(err.Error(), string(bodyBytes))
-		} else {
-			r.Diagnostics.AddError(err.Error(), "API request failed")
-		}
+		diags = &r.Diagnostics
 	case *resource.ReadResponse:
-		if response != nil && response.StatusCode == 429 {
-			r.Diagnostics.AddError(err.Error(), "Rate limited. Please retry.")
-		} else if response != nil {
-			bodyBytes, _ := io.ReadAll(response.Body)
-			r.Diagnostics.AddError(err.Error(), string(bodyBytes))
-		} else {
-			r.Diagnostics.AddError(err.Error(), "API request failed")
-		}
+		diags = &r.Diagnostics
 	case *resource.UpdateResponse:
-		if response != nil && response.StatusCode == 429 {
-			r.Diagnostics.AddError(err.Error(), "Rate limited. Please retry.")
-		} else if response != nil {
-			bodyBytes, _ := io.ReadAll(response.Body)
-			r.Diagnostics.AddError(err.Error(), string(bodyBytes))
-		} else {
-			r.Diagnostics.AddError(err.Error(), "API request failed")
-		}
+		diags = &r.Diagnostics
 	case *resource.DeleteResponse:
-		if response != nil && response.StatusCode == 429 {
-			r.Diagnostics.AddError(err.Error(), "Rate limited. Please retry.")
-		} else if response != nil {
-			bodyBytes, _ := io.ReadAll(response.Body)
-			r.Diagnostics.AddError(err.Error(), string(bodyBytes))
-		} else {
-			r.Diagnostics.AddError(err.Error(), "API request failed")
-		}
+		diags = &r.Diagnostics
+	default:
+		return
 	}
+	utils.AddAPIErrorAt(diags, path.Root("results"), err, response)
 }
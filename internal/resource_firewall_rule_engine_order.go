@@ -10,9 +10,12 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -33,17 +36,18 @@ type firewallRuleEngineOrderResource struct {
 }
 
 type firewallRuleEngineOrderModel struct {
-	ID          types.String  `tfsdk:"id"`
-	FirewallID  types.Int64   `tfsdk:"firewall_id"`
-	Order       []types.Int64 `tfsdk:"order"`
-	LastUpdated types.String  `tfsdk:"last_updated"`
+	ID          types.String   `tfsdk:"id"`
+	FirewallID  types.Int64    `tfsdk:"firewall_id"`
+	Order       []types.Int64  `tfsdk:"order"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *firewallRuleEngineOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_firewall_rule_engine_order"
 }
 
-func (r *firewallRuleEngineOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *firewallRuleEngineOrderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -53,8 +57,11 @@ func (r *firewallRuleEngineOrderResource) Schema(_ context.Context, _ resource.S
 				},
 			},
 			"firewall_id": schema.Int64Attribute{
-				Description: "The firewall identifier whose rules are being ordered.",
+				Description: "The firewall identifier whose rules are being ordered. Changing it replaces the resource.",
 				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"order": schema.ListAttribute{
 				Description: "The ordered list of rule IDs. The first ID will be evaluated first.",
@@ -66,6 +73,14 @@ func (r *firewallRuleEngineOrderResource) Schema(_ context.Context, _ resource.S
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -84,6 +99,14 @@ func (r *firewallRuleEngineOrderResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_firewall_rule_engine_order to be created")
+	defer cancel()
+
 	r.applyOrder(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -104,6 +127,14 @@ func (r *firewallRuleEngineOrderResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_firewall_rule_engine_order to be read")
+	defer cancel()
+
 	firewallID, ok := parseFirewallOrderID(state.ID.ValueString(), state.FirewallID, resp)
 	if !ok {
 		return
@@ -111,6 +142,9 @@ func (r *firewallRuleEngineOrderResource) Read(ctx context.Context, req resource
 
 	currentOrder, removed, err := r.listOrderedRuleIDs(ctx, firewallID)
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		if removed {
 			resp.State.RemoveResource(ctx)
 			return
@@ -134,6 +168,14 @@ func (r *firewallRuleEngineOrderResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_firewall_rule_engine_order to be updated")
+	defer cancel()
+
 	var state firewallRuleEngineOrderModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -179,7 +221,7 @@ func (r *firewallRuleEngineOrderResource) ImportState(ctx context.Context, req r
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("firewall_id"), firewallID)...)
 }
 
-func (r *firewallRuleEngineOrderResource) applyOrder(ctx context.Context, plan *firewallRuleEngineOrderModel, diags diagAccumulator) {
+func (r *firewallRuleEngineOrderResource) applyOrder(ctx context.Context, plan *firewallRuleEngineOrderModel, diags *diag.Diagnostics) {
 	firewallID := plan.FirewallID.ValueInt64()
 
 	orderIDs := make([]int64, 0, len(plan.Order))
@@ -204,24 +246,8 @@ func (r *firewallRuleEngineOrderResource) applyOrder(ctx context.Context, plan *
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			_, response, err = utils.RetryOn429(func() (*azionapi.PaginatedFirewallRuleList, *http.Response, error) {
-				return r.client.api.FirewallsRulesEngineAPI.
-					OrderFirewallRules(ctx, firewallID).
-					FirewallRuleEngineOrderRequest(*body).
-					Execute()
-			}, 5)
-			if response != nil {
-				defer response.Body.Close()
-			}
-			if err != nil {
-				diags.AddError(err.Error(), "API request failed after too many retries")
-				return
-			}
-		} else {
-			appendBodyError(diags, response, err)
-			return
-		}
+		utils.AddAPIErrorAt(diags, path.Root("order"), err, response)
+		return
 	}
 }
 
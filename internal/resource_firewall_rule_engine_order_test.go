@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// testAccFirewallRuleEngineOrderConfig manages two rules in each of two
+// firewalls and orders the rules of firewall as listed in order, which names
+// the rules by their suffix.
+func testAccFirewallRuleEngineOrderConfig(firewall string, order ...string) string {
+	config := mockProviderConfig
+	for _, f := range []string{"first", "second"} {
+		config += fmt.Sprintf(`
+resource "azion_firewall_main_setting" "%[1]s" {
+  data = {
+    name = %[1]q
+  }
+}
+`, f)
+		for _, rule := range []string{"a", "b"} {
+			config += fmt.Sprintf(`
+resource "azion_firewall_rule_engine" "%[1]s_%[2]s" {
+  firewall_id = azion_firewall_main_setting.%[1]s.data.id
+  results = {
+    name = "%[1]s-%[2]s"
+    criteria = [
+      {
+        entries = [
+          {
+            criterion = {
+              conditional = "if"
+              variable    = "$${request_uri}"
+              operator    = "starts_with"
+              argument    = "/%[2]s"
+            }
+          }
+        ]
+      }
+    ]
+    behaviors = [
+      {
+        behavior = {
+          type = "deny"
+        }
+      }
+    ]
+  }
+}
+`, f, rule)
+		}
+	}
+
+	ids := ""
+	for i, rule := range order {
+		if i > 0 {
+			ids += ", "
+		}
+		ids += fmt.Sprintf("azion_firewall_rule_engine.%s_%s.results.id", firewall, rule)
+	}
+	return config + fmt.Sprintf(`
+resource "azion_firewall_rule_engine_order" "test" {
+  firewall_id = azion_firewall_main_setting.%s.data.id
+  order       = [%s]
+}
+`, firewall, ids)
+}
+
+func TestAccFirewallRuleEngineOrderResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_firewall_rule_engine_order.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleEngineOrderConfig("first", "b", "a"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "order.#", "2"),
+					resource.TestCheckResourceAttrPair(name, "order.0", "azion_firewall_rule_engine.first_b", "results.id"),
+					resource.TestCheckResourceAttrPair(name, "order.1", "azion_firewall_rule_engine.first_a", "results.id"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccFirewallRuleEngineOrderConfig("first", "a", "b"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "order.0", "azion_firewall_rule_engine.first_a", "results.id"),
+					resource.TestCheckResourceAttrPair(name, "order.1", "azion_firewall_rule_engine.first_b", "results.id"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccFirewallRuleEngineOrderConfig("second", "b", "a"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "firewall_id", "azion_firewall_main_setting.second", "data.id"),
+					resource.TestCheckResourceAttrPair(name, "order.0", "azion_firewall_rule_engine.second_b", "results.id"),
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccFirewallRuleEngineOrderConfig("second", "b", "a"), func() string {
+				return "/workspace/firewalls/" + id
+			}),
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccFirewallRuleEngineConfig(firewall, name, behavior string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_firewall_main_setting" "first" {
+  data = {
+    name = "first"
+  }
+}
+
+resource "azion_firewall_main_setting" "second" {
+  data = {
+    name = "second"
+  }
+}
+
+resource "azion_firewall_rule_engine" "test" {
+  firewall_id = azion_firewall_main_setting.%s.data.id
+  results = {
+    name        = %q
+    description = "block admin"
+    criteria = [
+      {
+        entries = [
+          {
+            criterion = {
+              conditional = "if"
+              variable    = "$${request_uri}"
+              operator    = "starts_with"
+              argument    = "/admin"
+            }
+          }
+        ]
+      }
+    ]
+    behaviors = [
+      {
+        behavior = {
+          type = %q
+        }
+      }
+    ]
+  }
+}
+`, firewall, name, behavior)
+}
+
+func TestAccFirewallRuleEngineResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_firewall_rule_engine.test"
+	var firewallID, ruleID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleEngineConfig("first", "admin", "deny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.name", "admin"),
+					resource.TestCheckResourceAttr(name, "results.behaviors.0.behavior.type", "deny"),
+					resource.TestCheckResourceAttr(name, "results.criteria.0.entries.0.criterion.argument", "/admin"),
+					testAccCaptureAttr(name, "id", &ruleID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccFirewallRuleEngineConfig("first", "admin-v2", "drop"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.name", "admin-v2"),
+					resource.TestCheckResourceAttr(name, "results.behaviors.0.behavior.type", "drop"),
+					testAccCheckAttrSame(name, "id", &ruleID),
+				),
+			},
+			{
+				Config: testAccFirewallRuleEngineConfig("second", "admin-v2", "drop"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "firewall_id", "azion_firewall_main_setting.second", "data.id"),
+					testAccCheckAttrChanged(name, "id", &ruleID),
+					testAccCaptureAttr(name, "firewall_id", &firewallID),
+					testAccCaptureAttr(name, "results.id", &ruleID),
+				),
+			},
+			testAccDeletedStep(t, server, testAccFirewallRuleEngineConfig("second", "admin-v2", "drop"), func() string {
+				return "/workspace/firewalls/" + firewallID + "/request_rules/" + ruleID
+			}),
+		},
+	})
+}
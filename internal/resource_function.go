@@ -2,13 +2,13 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,11 +36,13 @@ type functionResourceModel struct {
 	Function    *functionResourceResults `tfsdk:"function"`
 	ID          types.String             `tfsdk:"id"`
 	LastUpdated types.String             `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value           `tfsdk:"timeouts"`
 }
 
 type functionResourceResults struct {
 	ID                   types.Int64  `tfsdk:"id"`
 	Name                 types.String `tfsdk:"name"`
+	FullName             types.String `tfsdk:"full_name"`
 	LastEditor           types.String `tfsdk:"last_editor"`
 	LastModified         types.String `tfsdk:"last_modified"`
 	ProductVersion       types.String `tfsdk:"product_version"`
@@ -62,11 +64,12 @@ func (r *functionResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_function"
 }
 
-func (r *functionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *functionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "" +
 			"~> **Note about default_args**\n" +
-			"Parameter `default_args` must be specified with `jsonencode` function\n\n" +
+			"Parameter `default_args` must be specified with `jsonencode` function. " +
+			"Its values are stored in plain text in the Terraform state and returned by the API, so keep secrets in `azion_variable` resources with `secret` set instead\n\n" +
 			"~> **Note about Code**\n" +
 			"Parameter `code`: For prevent any inconsistent use the function trimspace() - https://developer.hashicorp.com/terraform/language/functions/trimspace\n Can be specified with local_file in - https://registry.terraform.io/providers/hashicorp/local/latest/docs/resources/file",
 		Attributes: map[string]schema.Attribute{
@@ -91,6 +94,10 @@ func (r *functionResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						Description: "Name of the function.",
 						Required:    true,
 					},
+					"full_name": schema.StringAttribute{
+						Description: "Name of the function in the API, which starts with the `default_name_prefix` of the provider.",
+						Computed:    true,
+					},
 					"last_editor": schema.StringAttribute{
 						Description: "The last editor of the function.",
 						Computed:    true,
@@ -158,6 +165,14 @@ func (r *functionResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -176,8 +191,16 @@ func (r *functionResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_function to be created")
+	defer cancel()
+
 	edgeFunction := azionapi.FunctionsRequest{
-		Name: plan.Function.Name.ValueString(),
+		Name: r.client.prefixName(plan.Function.Name.ValueString()),
 		Code: plan.Function.Code.ValueString(),
 	}
 
@@ -208,37 +231,11 @@ func (r *functionResource) Create(ctx context.Context, req resource.CreateReques
 
 	createFunction, response, err := r.client.api.FunctionsAPI.CreateFunction(ctx).FunctionsRequest(edgeFunction).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			createFunction, response, err = utils.RetryOn429(func() (*azionapi.FunctionResponse, *http.Response, error) {
-				return r.client.api.FunctionsAPI.CreateFunction(ctx).FunctionsRequest(edgeFunction).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("function"), err, response)
+		return
 	}
 
 	jsonArgsStr, err := utils.ConvertInterfaceToString(createFunction.Data.DefaultArgs)
@@ -254,7 +251,8 @@ func (r *functionResource) Create(ctx context.Context, req resource.CreateReques
 
 	plan.Function = &functionResourceResults{
 		ID:                   types.Int64Value(createFunction.Data.Id),
-		Name:                 types.StringValue(createFunction.Data.Name),
+		Name:                 types.StringValue(r.client.unprefixName(createFunction.Data.Name)),
+		FullName:             types.StringValue(createFunction.Data.Name),
 		Code:                 types.StringValue(createFunction.Data.Code),
 		DefaultArgs:          types.StringValue(jsonArgsStr),
 		ExecutionEnvironment: types.StringValue(*createFunction.Data.ExecutionEnvironment),
@@ -293,6 +291,14 @@ func (r *functionResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_function to be read")
+	defer cancel()
+
 	var functionId int64
 	var err error
 	if state.Function != nil {
@@ -310,41 +316,15 @@ func (r *functionResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	getFunction, response, err := r.client.api.FunctionsAPI.RetrieveFunction(ctx, functionId).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			getFunction, response, err = utils.RetryOn429(func() (*azionapi.FunctionResponse, *http.Response, error) {
-				return r.client.api.FunctionsAPI.RetrieveFunction(ctx, functionId).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("function"), err, response)
+		return
 	}
 
 	jsonArgsStr, err := utils.ConvertInterfaceToString(getFunction.Data.DefaultArgs)
@@ -360,7 +340,8 @@ func (r *functionResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	state.Function = &functionResourceResults{
 		ID:                   types.Int64Value(getFunction.Data.Id),
-		Name:                 types.StringValue(getFunction.Data.Name),
+		Name:                 types.StringValue(r.client.unprefixName(getFunction.Data.Name)),
+		FullName:             types.StringValue(getFunction.Data.Name),
 		Code:                 types.StringValue(getFunction.Data.Code),
 		DefaultArgs:          types.StringValue(jsonArgsStr),
 		ExecutionEnvironment: types.StringValue(*getFunction.Data.ExecutionEnvironment),
@@ -397,6 +378,14 @@ func (r *functionResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_function to be updated")
+	defer cancel()
+
 	var state functionResourceModel
 	diagsFunction := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsFunction...)
@@ -408,7 +397,7 @@ func (r *functionResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Only include optional fields if they are set
 	if !plan.Function.Name.IsNull() && !plan.Function.Name.IsUnknown() {
-		updateFunctionRequest.SetName(plan.Function.Name.ValueString())
+		updateFunctionRequest.SetName(r.client.prefixName(plan.Function.Name.ValueString()))
 	}
 
 	if !plan.Function.Code.IsNull() && !plan.Function.Code.IsUnknown() {
@@ -456,37 +445,11 @@ func (r *functionResource) Update(ctx context.Context, req resource.UpdateReques
 
 	updateFunction, response, err := r.client.api.FunctionsAPI.PartialUpdateFunction(ctx, functionId).PatchedFunctionsRequest(updateFunctionRequest).Execute() //nolint
 	if err != nil {
-		if response.StatusCode == 429 {
-			updateFunction, response, err = utils.RetryOn429(func() (*azionapi.FunctionResponse, *http.Response, error) {
-				return r.client.api.FunctionsAPI.PartialUpdateFunction(ctx, functionId).PatchedFunctionsRequest(updateFunctionRequest).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close() // <-- Close the body here
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("function"), err, response)
+		return
 	}
 
 	jsonArgsStr, err := utils.ConvertInterfaceToString(updateFunction.Data.DefaultArgs)
@@ -502,7 +465,8 @@ func (r *functionResource) Update(ctx context.Context, req resource.UpdateReques
 
 	plan.Function = &functionResourceResults{
 		ID:                   types.Int64Value(updateFunction.Data.Id),
-		Name:                 types.StringValue(updateFunction.Data.Name),
+		Name:                 types.StringValue(r.client.unprefixName(updateFunction.Data.Name)),
+		FullName:             types.StringValue(updateFunction.Data.Name),
 		Code:                 types.StringValue(updateFunction.Data.Code),
 		DefaultArgs:          types.StringValue(jsonArgsStr),
 		ExecutionEnvironment: types.StringValue(*updateFunction.Data.ExecutionEnvironment),
@@ -541,6 +505,14 @@ func (r *functionResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_function to be deleted")
+	defer cancel()
+
 	var functionId int64
 	var err error
 	if state.Function != nil {
@@ -556,28 +528,20 @@ func (r *functionResource) Delete(ctx context.Context, req resource.DeleteReques
 		}
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.FunctionsAPI.DeleteFunction(ctx, functionId).Execute() //nolint
 	}, 5) // Maximum 5 retries
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("function"), err, response)
 		return
 	}
 }
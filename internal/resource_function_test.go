@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccFunctionConfig(name, code string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_function" "test" {
+  function = {
+    name         = %q
+    code         = %q
+    active       = true
+    default_args = jsonencode({ key = "value" })
+  }
+}
+`, name, code)
+}
+
+func TestAccFunctionResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_function.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig("hello", "addEventListener('fetch', () => {})"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "function.name", "hello"),
+					resource.TestCheckResourceAttr(name, "function.default_args", `{"key":"value"}`),
+					resource.TestCheckResourceAttrSet(name, "function.runtime"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccFunctionConfig("hello-v2", "addEventListener('fetch', (e) => {})"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "function.name", "hello-v2"),
+					resource.TestCheckResourceAttr(name, "function.code", "addEventListener('fetch', (e) => {})"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccFunctionConfig("hello-v2", "addEventListener('fetch', (e) => {})"),
+				Taint:  []string{name},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccFunctionConfig("hello-v2", "addEventListener('fetch', (e) => {})"), func() string {
+				return "/workspace/functions/" + id
+			}),
+		},
+	})
+}
@@ -2,13 +2,13 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -37,6 +37,7 @@ type NetworkListResourceModel struct {
 	NetworkList   *NetworkListResourceResults `tfsdk:"results"`
 	ID            types.String                `tfsdk:"id"`
 	LastUpdated   types.String                `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value              `tfsdk:"timeouts"`
 }
 
 type NetworkListResourceResults struct {
@@ -46,6 +47,7 @@ type NetworkListResourceResults struct {
 	CreatedAt    types.String `tfsdk:"created_at"`
 	Type         types.String `tfsdk:"type"`
 	Name         types.String `tfsdk:"name"`
+	FullName     types.String `tfsdk:"full_name"`
 	Items        types.Set    `tfsdk:"items"`
 	IsVersioned  types.Bool   `tfsdk:"is_versioned"`
 	Version      types.Int64  `tfsdk:"version"`
@@ -57,7 +59,7 @@ func (r *networkListResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_network_list"
 }
 
-func (r *networkListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *networkListResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -100,6 +102,10 @@ func (r *networkListResource) Schema(_ context.Context, _ resource.SchemaRequest
 						Description: "Name of the network list.",
 						Required:    true,
 					},
+					"full_name": schema.StringAttribute{
+						Description: "Name of the network list in the API, which starts with the `default_name_prefix` of the provider.",
+						Computed:    true,
+					},
 					"items": schema.SetAttribute{
 						Required:    true,
 						ElementType: types.StringType,
@@ -124,6 +130,14 @@ func (r *networkListResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -142,6 +156,14 @@ func (r *networkListResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_network_list to be created")
+	defer cancel()
+
 	var items []string
 	diagsItems := plan.NetworkList.Items.ElementsAs(ctx, &items, false)
 	resp.Diagnostics.Append(diagsItems...)
@@ -150,51 +172,18 @@ func (r *networkListResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	networkListRequest := azionapi.NetworkListRequest{
-		Name:  plan.NetworkList.Name.ValueString(),
+		Name:  r.client.prefixName(plan.NetworkList.Name.ValueString()),
 		Type:  plan.NetworkList.Type.ValueString(),
 		Items: items,
 	}
 
 	createNetworkListResponse, response, err := r.client.api.NetworkListsAPI.CreateNetworkList(ctx).NetworkListRequest(networkListRequest).Execute() //nolint
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			createNetworkListResponse, response, err = utils.RetryOn429(func() (*azionapi.NetworkListResponse, *http.Response, error) {
-				return r.client.api.NetworkListsAPI.CreateNetworkList(ctx).NetworkListRequest(networkListRequest).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			if response != nil && response.Body != nil {
-				bodyBytes, errReadAll := io.ReadAll(response.Body)
-				if errReadAll != nil {
-					resp.Diagnostics.AddError(
-						errReadAll.Error(),
-						"error reading response from API",
-					)
-				}
-				bodyString := string(bodyBytes)
-				resp.Diagnostics.AddError(
-					err.Error(),
-					bodyString,
-				)
-			} else {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed",
-				)
-			}
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
 	}
 
 	plan.SchemaVersion = types.Int64Value(1)
@@ -211,7 +200,8 @@ func (r *networkListResource) Create(ctx context.Context, req resource.CreateReq
 		LastModified: types.StringValue(data.GetLastModified().Format(time.RFC3339)),
 		CreatedAt:    types.StringValue(data.GetCreatedAt().Format(time.RFC3339)),
 		Type:         types.StringValue(data.GetType()),
-		Name:         types.StringValue(data.GetName()),
+		Name:         types.StringValue(r.client.unprefixName(data.GetName())),
+		FullName:     types.StringValue(data.GetName()),
 		Items:        utils.SliceStringTypeToSet(sliceString),
 		IsVersioned:  types.BoolValue(data.IsVersioned),
 		Version:      types.Int64PointerValue(data.Version.Get()),
@@ -237,6 +227,14 @@ func (r *networkListResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_network_list to be read")
+	defer cancel()
+
 	var networkListId int64
 	if state.ID.IsNull() {
 		networkListId = state.NetworkList.ID.ValueInt64()
@@ -254,48 +252,15 @@ func (r *networkListResource) Read(ctx context.Context, req resource.ReadRequest
 
 	getNetworkList, response, err := r.client.api.NetworkListsAPI.RetrieveNetworkList(ctx, networkListId).Execute() //nolint
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response != nil && response.StatusCode == 429 {
-			getNetworkList, response, err = utils.RetryOn429(func() (*azionapi.NetworkListResponse, *http.Response, error) {
-				return r.client.api.NetworkListsAPI.RetrieveNetworkList(ctx, networkListId).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			if response != nil && response.Body != nil {
-				bodyBytes, errReadAll := io.ReadAll(response.Body)
-				if errReadAll != nil {
-					resp.Diagnostics.AddError(
-						errReadAll.Error(),
-						"error reading response from API",
-					)
-				}
-				bodyString := string(bodyBytes)
-				resp.Diagnostics.AddError(
-					err.Error(),
-					bodyString,
-				)
-			} else {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed",
-				)
-			}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
 	}
 
 	data := getNetworkList.GetData()
@@ -312,14 +277,16 @@ func (r *networkListResource) Read(ctx context.Context, req resource.ReadRequest
 			LastModified: types.StringValue(data.GetLastModified().Format(time.RFC3339)),
 			CreatedAt:    types.StringValue(data.GetCreatedAt().Format(time.RFC3339)),
 			Type:         types.StringValue(data.GetType()),
-			Name:         types.StringValue(data.GetName()),
+			Name:         types.StringValue(r.client.unprefixName(data.GetName())),
+			FullName:     types.StringValue(data.GetName()),
 			Items:        utils.SliceStringTypeToSet(sliceString),
 			IsVersioned:  types.BoolValue(data.IsVersioned),
 			Version:      types.Int64PointerValue(data.Version.Get()),
 			VersionState: types.StringPointerValue(data.VersionState.Get()),
 			VersionID:    types.StringPointerValue(data.VersionId.Get()),
 		},
-		ID: types.StringValue(strconv.FormatInt(data.GetId(), 10)),
+		ID:       types.StringValue(strconv.FormatInt(data.GetId(), 10)),
+		Timeouts: state.Timeouts,
 	}
 
 	diags = resp.State.Set(ctx, &networkListState)
@@ -337,6 +304,14 @@ func (r *networkListResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_network_list to be updated")
+	defer cancel()
+
 	var state NetworkListResourceModel
 	diagsNetworkList := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsNetworkList...)
@@ -367,51 +342,18 @@ func (r *networkListResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	networkListRequest := azionapi.NetworkListRequest{
-		Name:  plan.NetworkList.Name.ValueString(),
+		Name:  r.client.prefixName(plan.NetworkList.Name.ValueString()),
 		Type:  plan.NetworkList.Type.ValueString(),
 		Items: items,
 	}
 
 	updateNetworkList, response, err := r.client.api.NetworkListsAPI.UpdateNetworkList(ctx, networkListId).NetworkListRequest(networkListRequest).Execute() //nolint
 	if err != nil {
-		if response != nil && response.StatusCode == 429 {
-			updateNetworkList, response, err = utils.RetryOn429(func() (*azionapi.NetworkListResponse, *http.Response, error) {
-				return r.client.api.NetworkListsAPI.UpdateNetworkList(ctx, networkListId).NetworkListRequest(networkListRequest).Execute() //nolint
-			}, 5) // Maximum 5 retries
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			if response != nil && response.Body != nil {
-				bodyBytes, errReadAll := io.ReadAll(response.Body)
-				if errReadAll != nil {
-					resp.Diagnostics.AddError(
-						errReadAll.Error(),
-						"error reading response from API",
-					)
-				}
-				bodyString := string(bodyBytes)
-				resp.Diagnostics.AddError(
-					err.Error(),
-					bodyString,
-				)
-			} else {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed",
-				)
-			}
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
+		return
 	}
 
 	plan.SchemaVersion = types.Int64Value(1)
@@ -428,7 +370,8 @@ func (r *networkListResource) Update(ctx context.Context, req resource.UpdateReq
 		LastModified: types.StringValue(data.GetLastModified().Format(time.RFC3339)),
 		CreatedAt:    types.StringValue(data.GetCreatedAt().Format(time.RFC3339)),
 		Type:         types.StringValue(data.GetType()),
-		Name:         types.StringValue(data.GetName()),
+		Name:         types.StringValue(r.client.unprefixName(data.GetName())),
+		FullName:     types.StringValue(data.GetName()),
 		Items:        utils.SliceStringTypeToSet(sliceString),
 		IsVersioned:  types.BoolValue(data.IsVersioned),
 		Version:      types.Int64PointerValue(data.Version.Get()),
@@ -454,6 +397,14 @@ func (r *networkListResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_network_list to be deleted")
+	defer cancel()
+
 	var networkListId int64
 	if state.ID.IsNull() {
 		networkListId = state.NetworkList.ID.ValueInt64()
@@ -469,35 +420,20 @@ func (r *networkListResource) Delete(ctx context.Context, req resource.DeleteReq
 		networkListId = id
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.NetworkListsAPI.DeleteNetworkList(ctx, networkListId).Execute() //nolint
 	}, 5) // Maximum 5 retries
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response != nil && response.Body != nil {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"error reading response from API",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-		} else {
-			resp.Diagnostics.AddError(
-				err.Error(),
-				"API request failed",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("results"), err, response)
 		return
 	}
 }
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetworkListConfig(name, items string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_network_list" "test" {
+  results = {
+    name  = %q
+    type  = "ip_cidr"
+    items = [%s]
+  }
+}
+`, name, items)
+}
+
+func TestAccNetworkListResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_network_list.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkListConfig("office", `"10.0.0.0/8"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.name", "office"),
+					resource.TestCheckResourceAttr(name, "results.type", "ip_cidr"),
+					resource.TestCheckTypeSetElemAttr(name, "results.items.*", "10.0.0.0/8"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccNetworkListConfig("office-v2", `"10.0.0.0/8", "192.168.0.0/16"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "results.name", "office-v2"),
+					resource.TestCheckResourceAttr(name, "results.items.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "results.items.*", "192.168.0.0/16"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccNetworkListConfig("office-v2", `"10.0.0.0/8", "192.168.0.0/16"`),
+				Taint:  []string{name},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccNetworkListConfig("office-v2", `"10.0.0.0/8", "192.168.0.0/16"`), func() string {
+				return "/workspace/network_lists/" + id
+			}),
+		},
+	})
+}
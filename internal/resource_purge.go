@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &purgeResource{}
+	_ resource.ResourceWithConfigure      = &purgeResource{}
+	_ resource.ResourceWithValidateConfig = &purgeResource{}
+)
+
+// purgeTypes maps the purge types of the resource to the ones of the API.
+var purgeTypes = map[string]string{
+	"url":       "url",
+	"cache_key": "cachekey",
+	"wildcard":  "wildcard",
+}
+
+// purgeLayers maps the cache layers of the resource to the ones of the API.
+var purgeLayers = map[string]string{
+	"edge_cache":   "cache",
+	"tiered_cache": "tiered_cache",
+}
+
+func NewPurgeResource() resource.Resource {
+	return &purgeResource{}
+}
+
+type purgeResource struct {
+	client *apiClient
+}
+
+type purgeResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Type        types.String   `tfsdk:"type"`
+	Targets     types.List     `tfsdk:"targets"`
+	Layer       types.String   `tfsdk:"layer"`
+	Triggers    types.Map      `tfsdk:"triggers"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *purgeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_purge"
+}
+
+func (r *purgeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for purging content from the Azion cache. The purge runs when the resource is created, " +
+			"and again whenever any argument changes, such as a value of `triggers`. Destroying the resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the purge.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last purge requested by Terraform.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of purge: `url` purges the URLs in `targets`, `cache_key` the cache keys and `wildcard` the URLs matching the patterns, such as `www.example.com/images/*`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("url", "cache_key", "wildcard"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"targets": schema.ListAttribute{
+				Description: "URLs, cache keys or wildcard patterns to purge, depending on `type`.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"layer": schema.StringAttribute{
+				Description: "Cache layer to purge: `edge_cache` or `tiered_cache`. Defaults to `edge_cache`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("edge_cache"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("edge_cache", "tiered_cache"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that purge again when they change, such as the checksum of a storage object or the code of a function.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *purgeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*apiClient)
+}
+
+// ValidateConfig checks that wildcard purges have a wildcard in each target,
+// and that the other purges have none, as they would match nothing.
+func (r *purgeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config purgeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() || config.Targets.IsUnknown() {
+		return
+	}
+
+	wildcard := config.Type.ValueString() == "wildcard"
+	for i, target := range config.Targets.Elements() {
+		target, ok := target.(types.String)
+		if !ok || target.IsUnknown() || target.IsNull() {
+			continue
+		}
+		if strings.Contains(target.ValueString(), "*") != wildcard {
+			expected := "Only wildcard purges may have a * in their targets."
+			if wildcard {
+				expected = "The targets of a wildcard purge need a *, such as www.example.com/images/*."
+			}
+			resp.Diagnostics.AddAttributeError(path.Root("targets").AtListIndex(i), "Invalid purge target",
+				expected+" Got "+strconv.Quote(target.ValueString())+".")
+		}
+	}
+}
+
+func (r *purgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan purgeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_purge to be requested")
+	defer cancel()
+
+	var targets []string
+	resp.Diagnostics.Append(plan.Targets.ElementsAs(ctx, &targets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	purgeRequest := azionapi.NewPurgeRequest(targets)
+	purgeRequest.SetLayer(purgeLayers[plan.Layer.ValueString()])
+
+	_, response, err := r.client.api.PurgeAPI.
+		CreatePurgeRequest(ctx, purgeTypes[plan.Type.ValueString()]).
+		PurgeRequest(*purgeRequest).
+		Execute() //nolint
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+	if response != nil {
+		defer response.Body.Close()
+	}
+
+	now := time.Now()
+	plan.ID = types.StringValue(strconv.FormatInt(now.UnixNano(), 10))
+	plan.LastUpdated = types.StringValue(now.Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the state as it is, as a purge leaves nothing to read back.
+func (r *purgeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update only saves the timeouts, as every other argument purges again.
+func (r *purgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan purgeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the purge from the state, as purged content cannot be
+// restored.
+func (r *purgeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
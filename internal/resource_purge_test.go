@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/aziontech/terraform-provider-azion/internal/mockapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccPurgeConfig(content, purge string) string {
+	return testAccStorageObjectContentConfig("index.html", content) + purge
+}
+
+func TestAccPurgeResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_purge.test"
+	urlPurge := `
+resource "azion_purge" "test" {
+  type     = "url"
+  targets  = ["www.example.com/index.html", "www.example.com/"]
+  triggers = {
+    checksum = azion_storage_object.test.checksum
+  }
+}
+`
+	wildcardPurge := `
+resource "azion_purge" "test" {
+  type     = "wildcard"
+  targets  = ["www.example.com/*"]
+  layer    = "tiered_cache"
+  triggers = {
+    checksum = azion_storage_object.test.checksum
+  }
+}
+`
+	checkPurges := func(want ...mockapi.Purge) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			got := server.Purges()
+			if !slices.EqualFunc(got, want, func(a, b mockapi.Purge) bool {
+				return a.Type == b.Type && a.Layer == b.Layer && slices.Equal(a.Items, b.Items)
+			}) {
+				return fmt.Errorf("purges = %+v, want %+v", got, want)
+			}
+			return nil
+		}
+	}
+	first := mockapi.Purge{Type: "url", Items: []string{"www.example.com/index.html", "www.example.com/"}, Layer: "cache"}
+	wildcard := mockapi.Purge{Type: "wildcard", Items: []string{"www.example.com/*"}, Layer: "tiered_cache"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPurgeConfig("<html></html>", `
+resource "azion_purge" "test" {
+  type    = "wildcard"
+  targets = ["www.example.com/index.html"]
+}
+`),
+				ExpectError: regexp.MustCompile(`Invalid purge target`),
+			},
+			{
+				Config: testAccPurgeConfig("<html></html>", urlPurge),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "layer", "edge_cache"),
+					resource.TestCheckResourceAttrSet(name, "id"),
+					checkPurges(first),
+				),
+			},
+			{
+				// Nothing changed, so nothing is purged.
+				Config: testAccPurgeConfig("<html></html>", urlPurge),
+				Check:  checkPurges(first),
+			},
+			{
+				// A new checksum of the object purges again.
+				Config: testAccPurgeConfig("<html>v2</html>", urlPurge),
+				Check:  checkPurges(first, first),
+			},
+			{
+				Config: testAccPurgeConfig("<html>v2</html>", wildcardPurge),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "layer", "tiered_cache"),
+					checkPurges(first, first, wildcard),
+				),
+			},
+		},
+	})
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -11,6 +10,7 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,9 +36,11 @@ type recordResource struct {
 }
 
 type recordResourceModel struct {
-	ZoneId      types.String `tfsdk:"zone_id"`
-	Record      *recordModel `tfsdk:"record"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID          types.String   `tfsdk:"id"`
+	ZoneId      types.String   `tfsdk:"zone_id"`
+	Record      *recordModel   `tfsdk:"record"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type recordModel struct {
@@ -56,18 +58,26 @@ func (r *recordResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_intelligent_dns_record"
 }
 
-func (r *recordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *recordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the resource, in the format `<zone_id>/<record_id>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update.",
 				Computed:    true,
 			},
 			"zone_id": schema.StringAttribute{
-				Description: "The zone identifier to target for the resource.",
+				Description: "The zone identifier to target for the resource. Changing it replaces the record.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"record": schema.SingleNestedAttribute{
@@ -108,6 +118,14 @@ func (r *recordResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -127,6 +145,14 @@ func (r *recordResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_intelligent_dns_record to be created")
+	defer cancel()
+
 	zoneId, err := strconv.ParseInt(strings.TrimSpace(plan.ZoneId.ValueString()), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -163,35 +189,11 @@ func (r *recordResource) Create(ctx context.Context, req resource.CreateRequest,
 	createRecord, httpResponse, err := r.client.api.DNSRecordsAPI.CreateDnsRecord(ctx, zoneId).
 		RecordRequest(*recordReq).Execute() //nolint
 	if err != nil {
-		if httpResponse.StatusCode == 429 {
-			createRecord, httpResponse, err = utils.RetryOn429(func() (*azionapi.RecordResponse, *http.Response, error) {
-				return r.client.api.DNSRecordsAPI.CreateDnsRecord(ctx, zoneId).
-					RecordRequest(*recordReq).Execute()
-			}, 5) // Maximum 5 retries
-
-			if httpResponse != nil {
-				defer httpResponse.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errorPrintRecord(httpResponse.StatusCode, err)
-			// Read response body for more error details
-			if httpResponse != nil && httpResponse.Body != nil {
-				bodyBytes, readErr := io.ReadAll(httpResponse.Body)
-				if readErr == nil && len(bodyBytes) > 0 {
-					errMsg = fmt.Sprintf("%s\nDetails: %s", errMsg, string(bodyBytes))
-				}
-			}
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("record"), err, httpResponse)
+		return
 	}
 
 	if httpResponse != nil {
@@ -201,6 +203,7 @@ func (r *recordResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Update plan with response.
 	plan.ZoneId = types.StringValue(plan.ZoneId.ValueString())
 	plan.Record = populateRecordModel(createRecord.GetData(), plan.Record.Policy.ValueString())
+	plan.ID = types.StringValue(recordID(plan.ZoneId.ValueString(), createRecord.Data.GetId()))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -220,6 +223,14 @@ func (r *recordResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_intelligent_dns_record to be read")
+	defer cancel()
+
 	// Parse zone_id and record_id from state.
 	// Format: "zone_id/record_id" for import, or just "zone_id" for existing state.
 	valueFromCmd := strings.Split(state.ZoneId.ValueString(), "/")
@@ -249,31 +260,15 @@ func (r *recordResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Retrieve the record.
 	recordResponse, httpResponse, err := r.client.api.DNSRecordsAPI.RetrieveDnsRecord(ctx, recordId, zoneId).Execute() //nolint
 	if err != nil {
-		if httpResponse.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if httpResponse.StatusCode == 429 {
-			recordResponse, httpResponse, err = utils.RetryOn429(func() (*azionapi.RecordResponse, *http.Response, error) {
-				return r.client.api.DNSRecordsAPI.RetrieveDnsRecord(ctx, recordId, zoneId).Execute()
-			}, 5) // Maximum 5 retries
-
-			if httpResponse != nil {
-				defer httpResponse.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errorPrintRecord(httpResponse.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("record"), err, httpResponse)
+		return
 	}
 
 	if httpResponse != nil {
@@ -283,6 +278,7 @@ func (r *recordResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Update state with response.
 	state.ZoneId = types.StringValue(valueFromCmd[0])
 	state.Record = populateRecordModel(recordResponse.GetData(), "")
+	state.ID = types.StringValue(recordID(valueFromCmd[0], recordResponse.Data.GetId()))
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -299,6 +295,14 @@ func (r *recordResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_intelligent_dns_record to be updated")
+	defer cancel()
+
 	var state recordResourceModel
 	diags2 := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags2...)
@@ -344,28 +348,11 @@ func (r *recordResource) Update(ctx context.Context, req resource.UpdateRequest,
 	updateRecord, httpResponse, err := r.client.api.DNSRecordsAPI.UpdateDnsRecord(ctx, recordId, zoneId).
 		RecordRequest(*recordReq).Execute() //nolint
 	if err != nil {
-		if httpResponse.StatusCode == 429 {
-			updateRecord, httpResponse, err = utils.RetryOn429(func() (*azionapi.RecordResponse, *http.Response, error) {
-				return r.client.api.DNSRecordsAPI.UpdateDnsRecord(ctx, recordId, zoneId).
-					RecordRequest(*recordReq).Execute()
-			}, 5) // Maximum 5 retries
-
-			if httpResponse != nil {
-				defer httpResponse.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errorPrintRecord(httpResponse.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("record"), err, httpResponse)
+		return
 	}
 
 	if httpResponse != nil {
@@ -375,6 +362,7 @@ func (r *recordResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update plan with response.
 	plan.ZoneId = types.StringValue(plan.ZoneId.ValueString())
 	plan.Record = populateRecordModel(updateRecord.GetData(), plan.Record.Policy.ValueString())
+	plan.ID = types.StringValue(recordID(plan.ZoneId.ValueString(), updateRecord.Data.GetId()))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -392,6 +380,14 @@ func (r *recordResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_intelligent_dns_record to be deleted")
+	defer cancel()
+
 	zoneId, err := strconv.ParseInt(strings.TrimSpace(state.ZoneId.ValueString()), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -404,18 +400,20 @@ func (r *recordResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	recordId := state.Record.Id.ValueInt64()
 
 	// Execute delete request.
-	_, httpResponse, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, httpResponse, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.DNSRecordsAPI.DeleteDnsRecord(ctx, recordId, zoneId).Execute()
 	}, 5) // Maximum 5 retries
 	if httpResponse != nil {
 		defer httpResponse.Body.Close()
 	}
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
 			return
 		}
-		usrMsg, errMsg := errorPrintRecord(httpResponse.StatusCode, err)
-		resp.Diagnostics.AddError(usrMsg, errMsg)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("record"), err, httpResponse)
 		return
 	}
 }
@@ -424,6 +422,12 @@ func (r *recordResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("zone_id"), req, resp)
 }
 
+// recordID returns the identifier of a record resource, which is also its
+// import ID.
+func recordID(zoneID string, id int64) string {
+	return zoneID + "/" + strconv.FormatInt(id, 10)
+}
+
 // buildRdataList converts a slice of types.String to a slice of string.
 func buildRdataList(rdata []types.String) []string {
 	result := make([]string, len(rdata))
@@ -479,21 +483,3 @@ func populateRecordModel(record azionapi.Record, policyOverride string) *recordM
 
 	return model
 }
-
-// errorPrintRecord returns user-friendly error messages for record operations.
-func errorPrintRecord(errCode int, err error) (string, string) {
-	var usrMsg string
-	switch errCode {
-	case 400:
-		usrMsg = "Bad Request"
-	case 401:
-		usrMsg = "Unauthorized Token"
-	case 404:
-		usrMsg = "Record Not Found"
-	default:
-		usrMsg = err.Error()
-	}
-
-	errMsg := fmt.Sprintf("%d - %s", errCode, usrMsg)
-	return usrMsg, errMsg
-}
@@ -1,70 +1,88 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func TestAccResourceRecord(t *testing.T) {
-	// * * * * This test will be implemented
-	// * * * * in the next project phase
+func testAccRecordConfig(zone, name, rdata string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_intelligent_dns_zone" "first" {
+  zone = {
+    name   = "first"
+    domain = "example.com"
+    active = true
+  }
+}
+
+resource "azion_intelligent_dns_zone" "second" {
+  zone = {
+    name   = "second"
+    domain = "example.org"
+    active = true
+  }
+}
+
+resource "azion_intelligent_dns_record" "test" {
+  zone_id = azion_intelligent_dns_zone.%s.id
+  record = {
+    type   = "A"
+    name   = %q
+    rdata  = [%q]
+    policy = "simple"
+    ttl    = 20
+  }
+}
+`, zone, name, rdata)
+}
+
+func TestAccRecordResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_intelligent_dns_record.test"
+	var zoneID, recordID string
 
-	//	resource.Test(t, resource.TestCase{
-	//		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-	//		Steps: []resource.TestStep{
-	//			// Create and Read testing
-	//			{
-	//				Config: providerConfig + `
-	//				resource "azion_intelligent_dns_record" "dev" {
-	//					zone_id = "2638"
-	//					record = {
-	//					  type = "A"
-	//					  name = "www"
-	//					  rdata = [
-	//						  "1.1.1.1",
-	//						  "8.8.8.8"
-	//					  ]
-	//					  policy = "simple"
-	//					  ttl = 20
-	//					}
-	//				  }
-	//
-	// `,
-	//
-	//		Check: resource.ComposeAggregateTestCheckFunc(
-	//			resource.TestCheckResourceAttr("azion_intelligent_dns_record.dev", "zone_id", "2638"),
-	//		),
-	//	},
-	//	// ImportState testing
-	//	{
-	//		ResourceName:            "azion_intelligent_dns_record.dev",
-	//		ImportState:             true,
-	//		ImportStateVerify:       true,
-	//		ImportStateVerifyIgnore: []string{"last_updated"},
-	//	},
-	//	// Update and Read testing
-	//	{
-	//		Config: providerConfig + `
-	//		resource "azion_intelligent_dns_record" "dev" {
-	//			zone_id = "2638"
-	//			record = {
-	//			  type = "A"
-	//			  name = "ww2"
-	//			  rdata = [
-	//				"8.8.8.8",
-	//				"7.7.7.7"
-	//			  ]
-	//			  policy = "simple"
-	//			  ttl = 20
-	//			}
-	//		  }
-	//
-	// `,
-	//
-	//				Check: resource.ComposeAggregateTestCheckFunc(
-	//					resource.TestCheckResourceAttr("azion_intelligent_dns_record.dev", "zone_id", "2638"),
-	//				),
-	//			},
-	//			// Delete testing automatically occurs in TestCase
-	//		},
-	//	})
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordConfig("first", "www", "192.0.2.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "zone_id", "azion_intelligent_dns_zone.first", "id"),
+					resource.TestCheckResourceAttr(name, "record.name", "www"),
+					resource.TestCheckResourceAttr(name, "record.rdata.0", "192.0.2.1"),
+					testAccCaptureAttr(name, "zone_id", &zoneID),
+					testAccCaptureAttr(name, "record.id", &recordID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID(name, "zone_id", "record.id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccRecordConfig("first", "api", "192.0.2.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "record.name", "api"),
+					resource.TestCheckResourceAttr(name, "record.rdata.0", "192.0.2.2"),
+					testAccCheckAttrSame(name, "record.id", &recordID),
+				),
+			},
+			{
+				Config: testAccRecordConfig("second", "api", "192.0.2.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "zone_id", "azion_intelligent_dns_zone.second", "id"),
+					testAccCheckAttrChanged(name, "record.id", &recordID),
+					testAccCaptureAttr(name, "zone_id", &zoneID),
+					testAccCaptureAttr(name, "record.id", &recordID),
+				),
+			},
+			testAccDeletedStep(t, server, testAccRecordConfig("second", "api", "192.0.2.2"), func() string {
+				return "/workspace/dns/zones/" + zoneID + "/records/" + recordID
+			}),
+		},
+	})
 }
@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &storageCredentialResource{}
+	_ resource.ResourceWithConfigure      = &storageCredentialResource{}
+	_ resource.ResourceWithImportState    = &storageCredentialResource{}
+	_ resource.ResourceWithValidateConfig = &storageCredentialResource{}
+)
+
+func NewStorageCredentialResource() resource.Resource {
+	return &storageCredentialResource{}
+}
+
+type storageCredentialResource struct {
+	client *apiClient
+}
+
+type storageCredentialResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Buckets      types.Set      `tfsdk:"buckets"`
+	Capabilities types.Set      `tfsdk:"capabilities"`
+	Expiration   types.String   `tfsdk:"expiration"`
+	AccessKey    types.String   `tfsdk:"access_key"`
+	SecretKey    types.String   `tfsdk:"secret_key"`
+	LastEditor   types.String   `tfsdk:"last_editor"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	LastModified types.String   `tfsdk:"last_modified"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *storageCredentialResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_credential"
+}
+
+func (r *storageCredentialResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing Azion Storage credentials, the S3-compatible access keys to the buckets. " +
+			"The API does not update credentials, so changing any argument replaces the credential and issues new keys.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the credential.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the resource.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the credential.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"buckets": schema.SetAttribute{
+				Description: "Names of the buckets the credential gives access to.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"capabilities": schema.SetAttribute{
+				Description: "Operations the credential allows, such as `listFiles`, `readFiles`, `writeFiles` and `deleteFiles`.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				Description: "Expiration of the credential, as an RFC 3339 timestamp such as `2026-12-31T23:59:59Z`. When not set, the credential does not expire.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				Description: "Access key of the credential.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret_key": schema.StringAttribute{
+				Description: "Secret key of the credential. The API returns it only when the credential is created, so it is not set on import.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_editor": schema.StringAttribute{
+				Description: "The last editor of the credential.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation timestamp of the credential.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_modified": schema.StringAttribute{
+				Description: "Last modified timestamp of the credential.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *storageCredentialResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*apiClient)
+}
+
+// ValidateConfig checks that the expiration is an RFC 3339 timestamp.
+func (r *storageCredentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var expiration types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expiration"), &expiration)...)
+	if resp.Diagnostics.HasError() || expiration.IsNull() || expiration.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, expiration.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expiration"), "Invalid timestamp",
+			fmt.Sprintf("Expected an RFC 3339 timestamp such as 2026-12-31T23:59:59Z: %s", err))
+	}
+}
+
+func (r *storageCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan storageCredentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_storage_credential to be created")
+	defer cancel()
+
+	var capabilities []string
+	resp.Diagnostics.Append(plan.Capabilities.ElementsAs(ctx, &capabilities, false)...)
+	credentialRequest := azionapi.NewCredentialCreateRequest(plan.Name.ValueString(), capabilities)
+	if !plan.Buckets.IsNull() {
+		var buckets []string
+		resp.Diagnostics.Append(plan.Buckets.ElementsAs(ctx, &buckets, false)...)
+		credentialRequest.SetBuckets(buckets)
+	}
+	if !plan.Expiration.IsNull() {
+		expiration, err := time.Parse(time.RFC3339, plan.Expiration.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expiration"), "Invalid timestamp", err.Error())
+		}
+		credentialRequest.SetExpirationDate(expiration)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createCredential, response, err := r.client.api.StorageCredentialsAPI.
+		CreateCredential(ctx).
+		CredentialCreateRequest(*credentialRequest).
+		Execute() //nolint
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+	if response != nil {
+		defer response.Body.Close()
+	}
+
+	resp.Diagnostics.Append(plan.populate(&createCredential.Data)...)
+	plan.SecretKey = types.StringValue(createCredential.Data.SecretKey)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *storageCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state storageCredentialResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_storage_credential to be read")
+	defer cancel()
+
+	credentialID, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID format",
+			err.Error(),
+		)
+		return
+	}
+
+	getCredential, response, err := r.client.api.StorageCredentialsAPI.
+		RetrieveCredential(ctx, credentialID).
+		Execute() //nolint
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+	if response != nil {
+		defer response.Body.Close()
+	}
+
+	// The secret key is kept from the state, as it is only returned on create.
+	resp.Diagnostics.Append(state.populate(&getCredential.Data)...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only saves the timeouts, as every other argument replaces the
+// credential.
+func (r *storageCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan storageCredentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *storageCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state storageCredentialResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_storage_credential to be deleted")
+	defer cancel()
+
+	credentialID, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID format",
+			err.Error(),
+		)
+		return
+	}
+
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
+		return r.client.api.StorageCredentialsAPI.
+			DeleteCredential(ctx, credentialID).
+			Execute() //nolint
+	}, 5)
+	if response != nil {
+		defer response.Body.Close()
+	}
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+}
+
+func (r *storageCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// populate sets the attributes of m the API returns for credential, other
+// than the secret key. The buckets and the expiration keep the form they have
+// in the configuration when the API returns the same values.
+func (m *storageCredentialResourceModel) populate(credential *azionapi.Credential) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(strconv.FormatInt(credential.Id, 10))
+	m.Name = types.StringValue(credential.Name)
+	m.AccessKey = types.StringValue(credential.AccessKey)
+	m.LastEditor = types.StringValue(credential.GetLastEditor())
+	m.CreatedAt = types.StringValue(credential.CreatedAt.Format(time.RFC3339))
+	m.LastModified = types.StringValue(credential.LastModified.Format(time.RFC3339))
+
+	var d diag.Diagnostics
+	m.Capabilities, d = types.SetValueFrom(context.Background(), types.StringType, credential.Capabilities)
+	diags.Append(d...)
+
+	if len(credential.Buckets) == 0 {
+		m.Buckets = types.SetNull(types.StringType)
+	} else {
+		m.Buckets, d = types.SetValueFrom(context.Background(), types.StringType, credential.Buckets)
+		diags.Append(d...)
+	}
+
+	if credential.ExpirationDate == nil {
+		m.Expiration = types.StringNull()
+	} else if current, err := time.Parse(time.RFC3339, m.Expiration.ValueString()); err != nil || !current.Equal(*credential.ExpirationDate) {
+		m.Expiration = types.StringValue(credential.ExpirationDate.Format(time.RFC3339))
+	}
+
+	return diags
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccStorageCredentialConfig(capabilities, expiration string) string {
+	return testAccBucketConfig("assets", "read_only") + fmt.Sprintf(`
+resource "azion_storage_credential" "test" {
+  name         = "deploy"
+  buckets      = [azion_bucket.test.bucket.name]
+  capabilities = %s
+  expiration   = %q
+}
+`, capabilities, expiration)
+}
+
+func TestAccStorageCredentialResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_storage_credential.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStorageCredentialConfig(`["readFiles"]`, "next year"),
+				ExpectError: regexp.MustCompile(`Invalid timestamp`),
+			},
+			{
+				Config: testAccStorageCredentialConfig(`["listFiles", "readFiles"]`, "2030-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "buckets.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "buckets.*", "assets"),
+					resource.TestCheckResourceAttr(name, "capabilities.#", "2"),
+					resource.TestCheckResourceAttr(name, "expiration", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(name, "access_key"),
+					resource.TestCheckResourceAttrSet(name, "secret_key"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key", "last_updated"},
+			},
+			{
+				// The API does not update credentials, so a change of the
+				// capabilities issues a new one.
+				Config: testAccStorageCredentialConfig(`["listFiles", "readFiles", "writeFiles"]`, "2030-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "capabilities.#", "3"),
+					resource.TestCheckResourceAttrSet(name, "secret_key"),
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccStorageCredentialConfig(`["listFiles", "readFiles", "writeFiles"]`, "2030-01-01T00:00:00Z"), func() string {
+				return "/workspace/storage/credentials/" + id
+			}),
+		},
+	})
+}
@@ -0,0 +1,553 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"maps"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &storageDirectorySyncResource{}
+	_ resource.ResourceWithConfigure   = &storageDirectorySyncResource{}
+	_ resource.ResourceWithImportState = &storageDirectorySyncResource{}
+	_ resource.ResourceWithModifyPlan  = &storageDirectorySyncResource{}
+)
+
+func NewStorageDirectorySyncResource() resource.Resource {
+	return &storageDirectorySyncResource{}
+}
+
+type storageDirectorySyncResource struct {
+	client *apiClient
+}
+
+type storageDirectorySyncResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Bucket        types.String   `tfsdk:"bucket"`
+	Prefix        types.String   `tfsdk:"prefix"`
+	SourceDir     types.String   `tfsdk:"source_dir"`
+	DeleteRemoved types.Bool     `tfsdk:"delete_removed"`
+	Files         types.Map      `tfsdk:"files"`
+	UntrackedKeys types.Set      `tfsdk:"untracked_keys"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// storageDirectorySyncFileModel is an entry of the manifest of the files
+// synced, keyed by their path relative to the directory.
+type storageDirectorySyncFileModel struct {
+	Checksum types.String `tfsdk:"checksum"`
+	Size     types.Int64  `tfsdk:"size"`
+}
+
+var storageDirectorySyncFileType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"checksum": types.StringType,
+	"size":     types.Int64Type,
+}}
+
+func (r *storageDirectorySyncResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_directory_sync"
+}
+
+func (r *storageDirectorySyncResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for keeping the objects under a prefix of an Azion Storage bucket in sync with a local directory.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the sync, in the bucket/prefix form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the resource.",
+				Computed:    true,
+			},
+			"bucket": schema.StringAttribute{
+				Description: "Name of the bucket to sync the directory to. Changing it replaces the sync.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "Prefix of the keys of the objects, such as `site/`, prepended to the path of each file relative to the directory. It is either empty or ends with `/`. Changing it replaces the sync.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(.*/)?$`), "must be empty or end with /"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_dir": schema.StringAttribute{
+				Description: "Path of the local directory to sync. Its files are uploaded recursively, with the content type of their extension, except empty ones, which cannot be uploaded.",
+				Required:    true,
+			},
+			"delete_removed": schema.BoolAttribute{
+				Description: "Whether to delete the objects under the prefix that have no file in the directory, including the ones not uploaded by Terraform. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"files": schema.MapNestedAttribute{
+				Description: "Manifest of the files synced, keyed by their path relative to the directory. A file added, changed or removed in the directory, or an object missing or with another size in the bucket, shows up as a change of the manifest.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"checksum": schema.StringAttribute{
+							Description: "SHA-256 of the content of the file, hex encoded.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "Size of the file, in bytes.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"untracked_keys": schema.SetAttribute{
+				Description: "Keys of the objects under the prefix that have no file in the directory, which the next apply deletes. Only tracked when `delete_removed` is set.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *storageDirectorySyncResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*apiClient)
+}
+
+// ModifyPlan plans the manifest of the directory, so that a change in it, or
+// in the bucket since the last sync, plans an update.
+func (r *storageDirectorySyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan storageDirectorySyncResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceDir.IsUnknown() {
+		plan.Files = types.MapUnknown(storageDirectorySyncFileType)
+	} else {
+		manifest, empty, ok := readDirectoryManifest(plan.SourceDir.ValueString(), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		if len(empty) > 0 {
+			resp.Diagnostics.AddAttributeWarning(path.Root("source_dir"), "Empty files not synced",
+				fmt.Sprintf("Empty objects cannot be uploaded, so these files of %s are skipped: %s.",
+					plan.SourceDir.ValueString(), strings.Join(empty, ", ")))
+		}
+		plan.Files, diags = types.MapValueFrom(ctx, storageDirectorySyncFileType, manifest)
+		resp.Diagnostics.Append(diags...)
+	}
+	switch {
+	case plan.DeleteRemoved.IsUnknown():
+		plan.UntrackedKeys = types.SetUnknown(types.StringType)
+	case plan.DeleteRemoved.ValueBool():
+		plan.UntrackedKeys = types.SetValueMust(types.StringType, []attr.Value{})
+	default:
+		plan.UntrackedKeys = types.SetNull(types.StringType)
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state storageDirectorySyncResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !plan.Files.Equal(state.Files) || !plan.UntrackedKeys.Equal(state.UntrackedKeys) {
+			plan.LastUpdated = types.StringUnknown()
+		}
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *storageDirectorySyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan storageDirectorySyncResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_storage_directory_sync to be created")
+	defer cancel()
+
+	r.sync(ctx, &plan, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read compares the manifest with the objects in the bucket: the files whose
+// object is missing are dropped from it, and the size of the others is the
+// size of their object, as hashing them would mean downloading every one.
+func (r *storageDirectorySyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state storageDirectorySyncResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_storage_directory_sync to be read")
+	defer cancel()
+
+	objects, response, err := listStorageObjects(ctx, r.client, state.Bucket.ValueString(), state.Prefix.ValueString())
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+
+	manifest := map[string]storageDirectorySyncFileModel{}
+	diags = state.Files.ElementsAs(ctx, &manifest, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sizes := map[string]int64{}
+	for _, object := range objects {
+		sizes[object.Key] = object.Size
+	}
+	prefix := state.Prefix.ValueString()
+	for file, entry := range manifest {
+		size, ok := sizes[prefix+file]
+		if !ok {
+			delete(manifest, file)
+			continue
+		}
+		entry.Size = types.Int64Value(size)
+		manifest[file] = entry
+	}
+	state.Files, diags = types.MapValueFrom(ctx, storageDirectorySyncFileType, manifest)
+	resp.Diagnostics.Append(diags...)
+
+	if state.DeleteRemoved.ValueBool() {
+		untracked := []string{}
+		for _, object := range objects {
+			file, ok := strings.CutPrefix(object.Key, prefix)
+			if !ok {
+				continue
+			}
+			if _, ok := manifest[file]; !ok {
+				untracked = append(untracked, object.Key)
+			}
+		}
+		state.UntrackedKeys, diags = types.SetValueFrom(ctx, types.StringType, untracked)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *storageDirectorySyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state storageDirectorySyncResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_storage_directory_sync to be updated")
+	defer cancel()
+
+	synced := map[string]storageDirectorySyncFileModel{}
+	diags = state.Files.ElementsAs(ctx, &synced, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.sync(ctx, &plan, synced, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the objects of the files in the manifest. The other objects
+// under the prefix are left alone, even with delete_removed.
+func (r *storageDirectorySyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state storageDirectorySyncResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_storage_directory_sync to be deleted")
+	defer cancel()
+
+	manifest := map[string]storageDirectorySyncFileModel{}
+	diags = state.Files.ElementsAs(ctx, &manifest, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for file := range manifest {
+		if !r.deleteObject(ctx, state.Bucket.ValueString(), state.Prefix.ValueString()+file, &resp.Diagnostics) {
+			return
+		}
+	}
+}
+
+// ImportState imports a sync from its bucket/prefix identifier, such as
+// assets/site/, or assets/ for the whole bucket. The directory comes from the
+// configuration, and the checksums of the objects are not imported, so the
+// first apply after an import uploads every file again.
+func (r *storageDirectorySyncResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bucket, prefix, ok := strings.Cut(req.ID, "/")
+	if !ok || bucket == "" || (prefix != "" && !strings.HasSuffix(prefix, "/")) {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the bucket/prefix form, such as assets/site/ or assets/, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), bucket)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prefix"), prefix)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_removed"), false)...)
+}
+
+// sync uploads the files of the directory that are not in synced, the
+// manifest of the last sync, or changed since, and deletes the untracked
+// objects when delete_removed is set. It fills in the computed attributes of
+// plan.
+func (r *storageDirectorySyncResource) sync(ctx context.Context, plan *storageDirectorySyncResourceModel, synced map[string]storageDirectorySyncFileModel, diags *diag.Diagnostics) {
+	sourceDir := plan.SourceDir.ValueString()
+	manifest, _, ok := readDirectoryManifest(sourceDir, diags)
+	if !ok {
+		return
+	}
+	if !plan.Files.IsUnknown() {
+		planned := map[string]storageDirectorySyncFileModel{}
+		diags.Append(plan.Files.ElementsAs(ctx, &planned, false)...)
+		if diags.HasError() {
+			return
+		}
+		if !maps.Equal(planned, manifest) {
+			diags.AddAttributeError(path.Root("source_dir"), "Source directory changed during apply",
+				fmt.Sprintf("The files in %s changed since the plan was made. Run terraform apply again to sync them.", sourceDir))
+			return
+		}
+	}
+
+	bucket, prefix := plan.Bucket.ValueString(), plan.Prefix.ValueString()
+	objects, response, err := listStorageObjects(ctx, r.client, bucket, prefix)
+	if err != nil {
+		if utils.AddTimeoutError(ctx, diags) {
+			return
+		}
+		utils.AddAPIError(diags, err, response)
+		return
+	}
+	existing := map[string]bool{}
+	for _, object := range objects {
+		existing[object.Key] = true
+	}
+
+	files := make([]string, 0, len(manifest))
+	for file := range manifest {
+		files = append(files, file)
+	}
+	slices.Sort(files)
+	for _, file := range files {
+		key := prefix + file
+		if last, ok := synced[file]; ok && last == manifest[file] && existing[key] {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(sourceDir, filepath.FromSlash(file)))
+		if err != nil {
+			diags.AddAttributeError(path.Root("source_dir"), "Unable to read file", err.Error())
+			return
+		}
+		contentType := mime.TypeByExtension(filepath.Ext(file))
+		response, err := uploadStorageObject(ctx, r.client, bucket, key, content, contentType, existing[key])
+		if err != nil {
+			if utils.AddTimeoutError(ctx, diags) {
+				return
+			}
+			utils.AddAPIError(diags, fmt.Errorf("uploading %s: %w", key, err), response)
+			return
+		}
+	}
+
+	if plan.DeleteRemoved.ValueBool() {
+		for _, object := range objects {
+			file, ok := strings.CutPrefix(object.Key, prefix)
+			if !ok {
+				continue
+			}
+			if _, ok := manifest[file]; ok {
+				continue
+			}
+			if !r.deleteObject(ctx, bucket, object.Key, diags) {
+				return
+			}
+		}
+		plan.UntrackedKeys = types.SetValueMust(types.StringType, []attr.Value{})
+	} else {
+		plan.UntrackedKeys = types.SetNull(types.StringType)
+	}
+
+	var d diag.Diagnostics
+	plan.Files, d = types.MapValueFrom(ctx, storageDirectorySyncFileType, manifest)
+	diags.Append(d...)
+	plan.ID = types.StringValue(storageObjectID(bucket, prefix))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
+
+// deleteObject deletes the object key of bucket, if it still exists.
+func (r *storageDirectorySyncResource) deleteObject(ctx context.Context, bucket, key string, diags *diag.Diagnostics) bool {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
+		return r.client.api.StorageObjectsAPI.DeleteObjectKey(ctx, bucket, key).Execute() //nolint
+	}, 5)
+	if response != nil {
+		defer response.Body.Close()
+	}
+	if err != nil {
+		if utils.AddTimeoutError(ctx, diags) {
+			return false
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return true
+		}
+		utils.AddAPIError(diags, fmt.Errorf("deleting %s: %w", key, err), response)
+		return false
+	}
+	return true
+}
+
+// readDirectoryManifest returns the manifest of the regular files under dir,
+// keyed by their slash separated path relative to it. Empty files cannot be
+// uploaded, so they are left out of the manifest and returned apart.
+func readDirectoryManifest(dir string, diags *diag.Diagnostics) (map[string]storageDirectorySyncFileModel, []string, bool) {
+	manifest := map[string]storageDirectorySyncFileModel{}
+	var empty []string
+	err := filepath.WalkDir(dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		relative, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		if len(content) == 0 {
+			empty = append(empty, filepath.ToSlash(relative))
+			return nil
+		}
+		manifest[filepath.ToSlash(relative)] = storageDirectorySyncFileModel{
+			Checksum: types.StringValue(storageObjectChecksum(content)),
+			Size:     types.Int64Value(int64(len(content))),
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_dir"), "Unable to read source directory", err.Error())
+		return nil, nil, false
+	}
+	return manifest, empty, true
+}
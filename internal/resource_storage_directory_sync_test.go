@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccStorageDirectorySyncConfig(dir string, deleteRemoved bool) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_storage_directory_sync" "test" {
+  bucket         = "assets"
+  prefix         = "site/"
+  source_dir     = %q
+  delete_removed = %t
+}
+`, dir, deleteRemoved)
+}
+
+func TestAccStorageDirectorySyncResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	if _, err := server.Create("/workspace/storage/buckets", map[string]any{"name": "assets", "workloads_access": "read_only"}); err != nil {
+		t.Fatal(err)
+	}
+	server.PutObject("assets", "site/old.html", []byte("<html>old</html>"), "")
+	server.PutObject("assets", "other/keep.txt", []byte("keep"), "")
+
+	const name = "azion_storage_directory_sync.test"
+	dir := t.TempDir()
+	writeFile := func(file, content string) {
+		t.Helper()
+		name := filepath.Join(dir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(name), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html>v1</html>")
+	writeFile("css/site.css", "body {}")
+	writeFile("img/logo.svg", "<svg></svg>")
+	// Empty files cannot be uploaded, so they are skipped.
+	writeFile("img/.keep", "")
+
+	// uploadsSince returns the keys uploaded since the request with index
+	// start, in order.
+	var start int
+	uploadsSince := func() []string {
+		var keys []string
+		for _, request := range server.Requests()[start:] {
+			if request.Method != http.MethodPost && request.Method != http.MethodPut {
+				continue
+			}
+			if _, key, ok := strings.Cut(request.Path, "/objects/"); ok {
+				keys = append(keys, key)
+			}
+		}
+		return keys
+	}
+	checkUploads := func(want ...string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if got := uploadsSince(); !slices.Equal(got, want) {
+				return fmt.Errorf("uploaded %v, want %v", got, want)
+			}
+			return nil
+		}
+	}
+	checkObjects := func(want map[string]bool) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			for key, exists := range want {
+				if _, _, ok := server.Object("assets", key); ok != exists {
+					return fmt.Errorf("object %s exists = %t, want %t", key, ok, exists)
+				}
+			}
+			return nil
+		}
+	}
+	markRequests := func() { start = len(server.Requests()) }
+	checkContentType := func(key, want string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			for _, request := range server.Requests() {
+				if request.Method == http.MethodPost && strings.HasSuffix(request.Path, "/objects/"+key) {
+					if got := request.Header.Get("Storage-Content-Type"); got != want {
+						return fmt.Errorf("%s uploaded as %q, want %q", key, got, want)
+					}
+					return nil
+				}
+			}
+			return fmt.Errorf("%s not uploaded", key)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: checkObjects(map[string]bool{
+			"site/index.html":   false,
+			"site/css/site.css": false,
+			"site/about.html":   false,
+			"other/keep.txt":    true,
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfig + `
+resource "azion_storage_directory_sync" "test" {
+  bucket     = "assets"
+  prefix     = "site"
+  source_dir = "."
+}
+`,
+				ExpectError: regexp.MustCompile(`must be empty or end with /`),
+			},
+			{
+				PreConfig: markRequests,
+				Config:    testAccStorageDirectorySyncConfig(dir, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", "assets/site/"),
+					resource.TestCheckResourceAttr(name, "files.%", "3"),
+					resource.TestCheckResourceAttr(name, "files.css/site.css.checksum", storageObjectChecksum([]byte("body {}"))),
+					resource.TestCheckResourceAttr(name, "files.css/site.css.size", "7"),
+					resource.TestCheckNoResourceAttr(name, "files.img/.keep.checksum"),
+					resource.TestCheckNoResourceAttr(name, "untracked_keys"),
+					checkUploads("site/css/site.css", "site/img/logo.svg", "site/index.html"),
+					checkContentType("site/css/site.css", "text/css; charset=utf-8"),
+					checkContentType("site/img/logo.svg", "image/svg+xml"),
+					checkObjects(map[string]bool{"site/old.html": true}),
+				),
+			},
+			{
+				// The checksums are not imported, so the next apply uploads
+				// every file again.
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_dir", "files", "last_updated"},
+			},
+			{
+				ResourceName:  name,
+				ImportState:   true,
+				ImportStateId: "assets/site",
+				ExpectError:   regexp.MustCompile(`Invalid import ID`),
+			},
+			{
+				// Only the files added or changed are uploaded.
+				PreConfig: func() {
+					markRequests()
+					writeFile("index.html", "<html>v2</html>")
+					writeFile("about.html", "<html>about</html>")
+					if err := os.Remove(filepath.Join(dir, "img", "logo.svg")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccStorageDirectorySyncConfig(dir, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "files.%", "3"),
+					resource.TestCheckNoResourceAttr(name, "files.img/logo.svg.checksum"),
+					checkUploads("site/about.html", "site/index.html"),
+					checkObjects(map[string]bool{"site/img/logo.svg": true, "site/old.html": true}),
+				),
+			},
+			{
+				PreConfig: markRequests,
+				Config:    testAccStorageDirectorySyncConfig(dir, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "untracked_keys.#", "0"),
+					checkUploads(),
+					checkObjects(map[string]bool{"site/img/logo.svg": false, "site/old.html": false, "other/keep.txt": true}),
+				),
+			},
+			{
+				// Objects missing or added in the bucket are drift.
+				PreConfig: func() {
+					server.DeleteObject("assets", "site/index.html")
+					server.PutObject("assets", "site/stray.txt", []byte("stray"), "")
+				},
+				Config:             testAccStorageDirectorySyncConfig(dir, true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: markRequests,
+				Config:    testAccStorageDirectorySyncConfig(dir, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkUploads("site/index.html"),
+					checkObjects(map[string]bool{"site/index.html": true, "site/stray.txt": false}),
+				),
+			},
+			{
+				// A change of size in the bucket is drift too.
+				PreConfig: func() {
+					server.PutObject("assets", "site/about.html", []byte("<html>edited</html>"), "")
+				},
+				Config:             testAccStorageDirectorySyncConfig(dir, true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: markRequests,
+				Config:    testAccStorageDirectorySyncConfig(dir, true),
+				Check:     checkUploads("site/about.html"),
+			},
+		},
+	})
+}
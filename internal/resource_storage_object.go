@@ -0,0 +1,574 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &storageObjectResource{}
+	_ resource.ResourceWithConfigure      = &storageObjectResource{}
+	_ resource.ResourceWithImportState    = &storageObjectResource{}
+	_ resource.ResourceWithModifyPlan     = &storageObjectResource{}
+	_ resource.ResourceWithValidateConfig = &storageObjectResource{}
+)
+
+func NewStorageObjectResource() resource.Resource {
+	return &storageObjectResource{}
+}
+
+type storageObjectResource struct {
+	client *apiClient
+}
+
+type storageObjectResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Bucket      types.String   `tfsdk:"bucket"`
+	Key         types.String   `tfsdk:"key"`
+	Source      types.String   `tfsdk:"source"`
+	Content     types.String   `tfsdk:"content"`
+	ContentType types.String   `tfsdk:"content_type"`
+	Checksum    types.String   `tfsdk:"checksum"`
+	Size        types.Int64    `tfsdk:"size"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *storageObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_object"
+}
+
+func (r *storageObjectResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing an object in an Azion Storage bucket.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the object, in the bucket/key form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the resource.",
+				Computed:    true,
+			},
+			"bucket": schema.StringAttribute{
+				Description: "Name of the bucket holding the object. Changing it replaces the object.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "Key of the object in the bucket, such as `css/site.css`. Changing it replaces the object.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "Path of the file to upload. Conflicts with `content`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Content of the object, as a string. Conflicts with `source`.",
+				Optional:    true,
+			},
+			"content_type": schema.StringAttribute{
+				Description: "MIME type of the object, such as `text/html`. When not set, the API detects it. Parameters the API adds, such as a charset, are not a change.",
+				Optional:    true,
+			},
+			"checksum": schema.StringAttribute{
+				Description: "SHA-256 of the content of the object, hex encoded. A change of the content in the bucket or in `source` shows up as a change of the checksum, and uploads the object again. A refresh only downloads the object when its size or time of modification changed.",
+				Computed:    true,
+			},
+			"size": schema.Int64Attribute{
+				Description: "Size of the object, in bytes.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *storageObjectResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*apiClient)
+}
+
+// ValidateConfig rejects empty inline content, as the SDK refuses to send a
+// request with an empty body, so empty objects cannot be uploaded.
+func (r *storageObjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var content types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content"), &content)...)
+	if !content.IsNull() && !content.IsUnknown() && content.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Empty object", "Empty objects cannot be uploaded.")
+	}
+}
+
+// ModifyPlan plans the checksum and size of the content configured, so that
+// a change of the file at source, or of the object in the bucket since it was
+// uploaded, plans an update.
+func (r *storageObjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan storageObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() || plan.Content.IsUnknown() {
+		plan.Checksum = types.StringUnknown()
+		plan.Size = types.Int64Unknown()
+	} else {
+		content, ok := readStorageObjectContent(plan, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		plan.Checksum = types.StringValue(storageObjectChecksum(content))
+		plan.Size = types.Int64Value(int64(len(content)))
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state storageObjectResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !plan.Checksum.Equal(state.Checksum) {
+			plan.LastUpdated = types.StringUnknown()
+		}
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *storageObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan storageObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_storage_object to be created")
+	defer cancel()
+
+	r.upload(ctx, &plan, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.recordVersion(ctx, plan, resp.Private)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *storageObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state storageObjectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_storage_object to be read")
+	defer cancel()
+
+	bucket, key := state.Bucket.ValueString(), state.Key.ValueString()
+	state.ID = types.StringValue(storageObjectID(bucket, key))
+	object, response, err := statStorageObject(ctx, r.client, bucket, key)
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+	// The object is only downloaded to compute its checksum when it changed
+	// since the last time it was read or uploaded.
+	version, diags := getStorageObjectVersion(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if object != nil && version != nil && version.matches(*object) && !state.Checksum.IsNull() {
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	content, contentType, response, err := downloadStorageObject(ctx, r.client, bucket, key)
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+
+	state.Checksum = types.StringValue(storageObjectChecksum(content))
+	state.Size = types.Int64Value(int64(len(content)))
+	// The content type is only tracked when it is set, as the one the API
+	// detects may change along with the content. The API may also add
+	// parameters, such as the charset, to the one set.
+	if !state.ContentType.IsNull() && !sameMediaType(state.ContentType.ValueString(), contentType) {
+		state.ContentType = types.StringValue(contentType)
+	}
+	if object != nil {
+		resp.Diagnostics.Append(setStorageObjectVersion(ctx, resp.Private, *object)...)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *storageObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan storageObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_storage_object to be updated")
+	defer cancel()
+
+	r.upload(ctx, &plan, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.recordVersion(ctx, plan, resp.Private)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *storageObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state storageObjectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_storage_object to be deleted")
+	defer cancel()
+
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
+		return r.client.api.StorageObjectsAPI.
+			DeleteObjectKey(ctx, state.Bucket.ValueString(), state.Key.ValueString()).
+			Execute() //nolint
+	}, 5)
+	if response != nil {
+		defer response.Body.Close()
+	}
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+}
+
+// ImportState imports an object from its bucket/key identifier. The content
+// is not imported, so the next plan shows source or content being set.
+func (r *storageObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bucket, key, ok := strings.Cut(req.ID, "/")
+	if !ok || bucket == "" || key == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the bucket/key form, such as assets/index.html, got %q.", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), bucket)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// upload uploads the content planned, creating the object or replacing it,
+// and fills in the computed attributes of plan.
+func (r *storageObjectResource) upload(ctx context.Context, plan *storageObjectResourceModel, replace bool, diags *diag.Diagnostics) {
+	content, ok := readStorageObjectContent(*plan, diags)
+	if !ok {
+		return
+	}
+	checksum := storageObjectChecksum(content)
+	if !plan.Checksum.IsUnknown() && plan.Checksum.ValueString() != checksum {
+		diags.AddAttributeError(path.Root("source"), "Source changed during apply",
+			fmt.Sprintf("The checksum of %s changed since the plan was made. Run terraform apply again to upload its new content.", plan.Source.ValueString()))
+		return
+	}
+
+	response, err := uploadStorageObject(ctx, r.client, plan.Bucket.ValueString(), plan.Key.ValueString(), content, plan.ContentType.ValueString(), replace)
+	if err != nil {
+		if utils.AddTimeoutError(ctx, diags) {
+			return
+		}
+		utils.AddAPIError(diags, err, response)
+		return
+	}
+
+	plan.ID = types.StringValue(storageObjectID(plan.Bucket.ValueString(), plan.Key.ValueString()))
+	plan.Checksum = types.StringValue(checksum)
+	plan.Size = types.Int64Value(int64(len(content)))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
+
+// recordVersion saves the version of the object just uploaded, so that the
+// next Read does not download it. Failing to list it only costs that
+// download.
+func (r *storageObjectResource) recordVersion(ctx context.Context, plan storageObjectResourceModel, private privateState) diag.Diagnostics {
+	object, _, err := statStorageObject(ctx, r.client, plan.Bucket.ValueString(), plan.Key.ValueString())
+	if err != nil || object == nil {
+		return nil
+	}
+	return setStorageObjectVersion(ctx, private, *object)
+}
+
+// readStorageObjectContent returns the content configured for an object,
+// inline or from the file at source.
+func readStorageObjectContent(model storageObjectResourceModel, diags *diag.Diagnostics) ([]byte, bool) {
+	if !model.Content.IsNull() {
+		return []byte(model.Content.ValueString()), true
+	}
+	content, err := os.ReadFile(model.Source.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "Unable to read source", err.Error())
+		return nil, false
+	}
+	if len(content) == 0 {
+		diags.AddAttributeError(path.Root("source"), "Empty object",
+			fmt.Sprintf("%s is empty, and empty objects cannot be uploaded.", model.Source.ValueString()))
+		return nil, false
+	}
+	return content, true
+}
+
+// storageObjectVersionKey is the private state key of the version of the
+// object whose checksum is in the state.
+const storageObjectVersionKey = "object_version"
+
+// privateState is the private state of a resource, as held by the responses
+// of the resource operations.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// storageObjectVersion identifies the content of an object by its size and
+// time of modification, which the listing of the bucket gives without
+// downloading it. The API lists no ETag.
+type storageObjectVersion struct {
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+func (v storageObjectVersion) matches(object azionapi.BucketObject) bool {
+	return v.Size == object.Size && v.LastModified.Equal(object.LastModified)
+}
+
+// getStorageObjectVersion returns the version saved in private, or nil when
+// there is none, such as after an import.
+func getStorageObjectVersion(ctx context.Context, private privateState) (*storageObjectVersion, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, storageObjectVersionKey)
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+	var version storageObjectVersion
+	if err := json.Unmarshal(data, &version); err != nil {
+		return nil, diags
+	}
+	return &version, diags
+}
+
+// setStorageObjectVersion saves the version of object in private.
+func setStorageObjectVersion(ctx context.Context, private privateState, object azionapi.BucketObject) diag.Diagnostics {
+	data, err := json.Marshal(storageObjectVersion{Size: object.Size, LastModified: object.LastModified})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unable to save the object version", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, storageObjectVersionKey, data)
+}
+
+// sameMediaType reports whether the content types a and b have the same
+// media type, whatever their parameters.
+func sameMediaType(a, b string) bool {
+	mediaTypeA, _, errA := mime.ParseMediaType(a)
+	mediaTypeB, _, errB := mime.ParseMediaType(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return mediaTypeA == mediaTypeB
+}
+
+// storageObjectID returns the identifier of an object, which is also its
+// import ID.
+func storageObjectID(bucket, key string) string {
+	return bucket + "/" + key
+}
+
+// storageObjectChecksum returns the hex encoded SHA-256 of content.
+func storageObjectChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// uploadStorageObject uploads content as the object key of bucket, replacing
+// the existing object when replace is set. The SDK only uploads files, so
+// the content goes through a temporary file.
+func uploadStorageObject(ctx context.Context, client *apiClient, bucket, key string, content []byte, contentType string, replace bool) (*http.Response, error) {
+	file, err := os.CreateTemp("", "azion-storage-object")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	if _, err := file.Write(content); err != nil {
+		return nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var response *http.Response
+	if replace {
+		request := client.api.StorageObjectsAPI.UpdateObjectKey(ctx, bucket, key).Body(file)
+		if contentType != "" {
+			request = request.StorageContentType(contentType)
+		}
+		_, response, err = request.Execute() //nolint
+	} else {
+		request := client.api.StorageObjectsAPI.CreateObjectKey(ctx, bucket, key).Body(file)
+		if contentType != "" {
+			request = request.StorageContentType(contentType)
+		}
+		_, response, err = request.Execute() //nolint
+	}
+	if response != nil && err == nil {
+		response.Body.Close()
+	}
+	return response, err
+}
+
+// statStorageObject returns the listing entry of the object key of bucket,
+// or nil when it is not listed first under its key. The keys are listed in
+// order, so the object comes before the others its key is a prefix of.
+func statStorageObject(ctx context.Context, client *apiClient, bucket, key string) (*azionapi.BucketObject, *http.Response, error) {
+	objectsResponse, response, err := client.api.StorageObjectsAPI.ListObjects(ctx, bucket).
+		Prefix(key).
+		AllLevels(false).
+		MaxObjectCount(1).
+		Execute() //nolint
+	if err != nil {
+		return nil, response, err
+	}
+	response.Body.Close()
+	for _, object := range objectsResponse.GetResults() {
+		if object.Key == key && !object.IsFolder {
+			return &object, nil, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// downloadStorageObject returns the content and the content type of the
+// object key of bucket.
+func downloadStorageObject(ctx context.Context, client *apiClient, bucket, key string) ([]byte, string, *http.Response, error) {
+	file, response, err := client.api.StorageObjectsAPI.DownloadObject(ctx, bucket, key).Execute() //nolint
+	if err != nil {
+		return nil, "", response, err
+	}
+	if response != nil {
+		defer response.Body.Close()
+	}
+	// The SDK saves the object in a temporary file, and returns no file for
+	// an empty one, which may have been uploaded outside Terraform.
+	if file == nil {
+		return nil, response.Header.Get("Content-Type"), response, nil
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, "", response, err
+	}
+	return content, response.Header.Get("Content-Type"), response, nil
+}
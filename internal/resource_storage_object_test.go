@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccStorageObjectConfig(object string) string {
+	return testAccBucketConfig("assets", "read_only") + object
+}
+
+func testAccStorageObjectContentConfig(key, content string) string {
+	return testAccStorageObjectConfig(fmt.Sprintf(`
+resource "azion_storage_object" "test" {
+  bucket  = azion_bucket.test.bucket.name
+  key     = %q
+  content = %q
+}
+`, key, content))
+}
+
+func TestAccStorageObjectResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_storage_object.test"
+	source := filepath.Join(t.TempDir(), "site.css")
+	writeSource := func(content string) {
+		if err := os.WriteFile(source, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSource("body { color: red; }")
+	sourceConfig := testAccStorageObjectConfig(fmt.Sprintf(`
+resource "azion_storage_object" "test" {
+  bucket       = azion_bucket.test.bucket.name
+  key          = "css/site.css"
+  source       = %q
+  content_type = "text/css"
+}
+
+data "azion_storage_objects" "css" {
+  bucket     = azion_bucket.test.bucket.name
+  prefix     = "css/"
+  depends_on = [azion_storage_object.test]
+}
+`, source))
+	downloads := func() int {
+		count := 0
+		for _, request := range server.Requests() {
+			if request.Method == "GET" && request.Path == "/workspace/storage/buckets/assets/objects/css/site.css" {
+				count++
+			}
+		}
+		return count
+	}
+	var downloaded int
+	checkObject := func(key, want string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			content, _, ok := server.Object("assets", key)
+			if !ok || string(content) != want {
+				return fmt.Errorf("object %s = %q, want %q", key, content, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			for _, key := range []string{"index.html", "css/site.css"} {
+				if _, _, ok := server.Object("assets", key); ok {
+					return fmt.Errorf("object %s still exists", key)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageObjectConfig(`
+resource "azion_storage_object" "test" {
+  bucket  = azion_bucket.test.bucket.name
+  key     = "index.html"
+  content = "<html></html>"
+  source  = "index.html"
+}
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccStorageObjectContentConfig("index.html", ""),
+				ExpectError: regexp.MustCompile(`Empty object`),
+			},
+			{
+				Config: testAccStorageObjectContentConfig("index.html", "<html></html>"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", "assets/index.html"),
+					resource.TestCheckResourceAttr(name, "size", "13"),
+					resource.TestCheckResourceAttr(name, "checksum", storageObjectChecksum([]byte("<html></html>"))),
+					checkObject("index.html", "<html></html>"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "last_updated"},
+			},
+			{
+				Config: testAccStorageObjectContentConfig("index.html", "<html>v2</html>"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "checksum", storageObjectChecksum([]byte("<html>v2</html>"))),
+					checkObject("index.html", "<html>v2</html>"),
+				),
+			},
+			{
+				// A change in the bucket is drift, undone by the next apply.
+				PreConfig: func() {
+					server.PutObject("assets", "index.html", []byte("<html>edited</html>"), "text/html")
+				},
+				Config:             testAccStorageObjectContentConfig("index.html", "<html>v2</html>"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccStorageObjectContentConfig("index.html", "<html>v2</html>"),
+				Check:  checkObject("index.html", "<html>v2</html>"),
+			},
+			{
+				Config: sourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", "assets/css/site.css"),
+					resource.TestCheckResourceAttr(name, "size", "20"),
+					resource.TestCheckResourceAttr(name, "content_type", "text/css"),
+					resource.TestCheckResourceAttr("data.azion_storage_objects.css", "counter", "1"),
+					resource.TestCheckResourceAttr("data.azion_storage_objects.css", "results.0.key", "css/site.css"),
+					resource.TestCheckResourceAttr("data.azion_storage_objects.css", "results.0.size", "20"),
+					checkObject("css/site.css", "body { color: red; }"),
+				),
+			},
+			{
+				// A change of the source file plans an upload.
+				PreConfig:          func() { writeSource("body { color: blue; }") },
+				Config:             sourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: sourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "size", "21"),
+					checkObject("css/site.css", "body { color: blue; }"),
+				),
+			},
+			{
+				// An object unchanged since it was uploaded is not downloaded.
+				PreConfig:    func() { downloaded = downloads() },
+				RefreshState: true,
+				Check: func(*terraform.State) error {
+					if got := downloads(); got != downloaded {
+						return fmt.Errorf("refresh downloaded the object %d times", got-downloaded)
+					}
+					return nil
+				},
+			},
+			{
+				// Parameters added to the content type are not drift.
+				PreConfig: func() {
+					server.PutObject("assets", "css/site.css", []byte("body { color: blue; }"), "text/css; charset=utf-8")
+				},
+				Config:   sourceConfig,
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					if !server.DeleteObject("assets", "css/site.css") {
+						t.Fatal("css/site.css not found in the mock API")
+					}
+				},
+				Config:             sourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: sourceConfig,
+				Check:  checkObject("css/site.css", "body { color: blue; }"),
+			},
+		},
+	})
+}
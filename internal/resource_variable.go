@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &variableResource{}
+	_ resource.ResourceWithConfigure   = &variableResource{}
+	_ resource.ResourceWithImportState = &variableResource{}
+	_ resource.ResourceWithModifyPlan  = &variableResource{}
+)
+
+func NewVariableResource() resource.Resource {
+	return &variableResource{}
+}
+
+type variableResource struct {
+	client *apiClient
+}
+
+type variableResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Key          types.String   `tfsdk:"key"`
+	Value        types.String   `tfsdk:"value"`
+	Secret       types.Bool     `tfsdk:"secret"`
+	ValueHash    types.String   `tfsdk:"value_hash"`
+	LastEditor   types.String   `tfsdk:"last_editor"`
+	LastModified types.String   `tfsdk:"last_modified"`
+	LastUpdated  types.String   `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *variableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variable"
+}
+
+func (r *variableResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for managing Azion variables, the environment variables and secrets the functions read at runtime. " +
+			"The API does not return the value of a secret variable, so its changes are tracked through `value_hash`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the variable.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the resource.",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Name of the variable, such as `API_TOKEN`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the variable. It is sensitive, but it is stored in the state like any other value.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"secret": schema.BoolAttribute{
+				Description: "Whether the variable is a secret, whose value the API does not return. Defaults to false. Changing it replaces the variable.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"value_hash": schema.StringAttribute{
+				Description: "SHA-256 of the value, hex encoded. A secret variable changed outside Terraform clears it, which plans sending the value again.",
+				Computed:    true,
+			},
+			"last_editor": schema.StringAttribute{
+				Description: "The last editor of the variable.",
+				Computed:    true,
+			},
+			"last_modified": schema.StringAttribute{
+				Description: "Last modified timestamp of the variable.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *variableResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*apiClient)
+}
+
+// ModifyPlan plans the hash of the value configured, so that a value_hash
+// cleared by Read plans an update even though the value did not change in
+// the configuration.
+func (r *variableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan variableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Value.IsUnknown() {
+		plan.ValueHash = types.StringUnknown()
+	} else {
+		plan.ValueHash = types.StringValue(variableValueHash(plan.Value.ValueString()))
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state variableResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The attributes the API sets only change along with the variable.
+		if plan.Key.Equal(state.Key) && plan.ValueHash.Equal(state.ValueHash) {
+			plan.LastEditor = state.LastEditor
+			plan.LastModified = state.LastModified
+			plan.LastUpdated = state.LastUpdated
+		} else {
+			plan.LastEditor = types.StringUnknown()
+			plan.LastModified = types.StringUnknown()
+			plan.LastUpdated = types.StringUnknown()
+		}
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *variableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan variableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_variable to be created")
+	defer cancel()
+
+	created, response, err := r.client.variableAPI(ctx, http.MethodPost, 0, plan.request())
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Empty(), err, response)
+		return
+	}
+
+	plan.populate(created)
+	plan.ValueHash = types.StringValue(variableValueHash(plan.Value.ValueString()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the value of a variable that is not secret. The value of a
+// secret variable is kept from the state, and its hash is cleared when the
+// variable was modified since Terraform last wrote it, as the new value
+// cannot be compared.
+func (r *variableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state variableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_variable to be read")
+	defer cancel()
+
+	variableID, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID format",
+			err.Error(),
+		)
+		return
+	}
+
+	current, response, err := r.client.variableAPI(ctx, http.MethodGet, variableID, nil)
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+
+	lastModified := state.LastModified
+	state.populate(current)
+	if !current.Secret {
+		state.Value = types.StringValue(current.Value)
+		state.ValueHash = types.StringValue(variableValueHash(current.Value))
+	} else if !lastModified.Equal(state.LastModified) {
+		state.ValueHash = types.StringValue("")
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *variableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan variableResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state variableResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A change of the timeouts alone is only saved.
+	if plan.Key.Equal(state.Key) && plan.ValueHash.Equal(state.ValueHash) {
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_variable to be updated")
+	defer cancel()
+
+	variableID, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID format",
+			err.Error(),
+		)
+		return
+	}
+
+	updated, response, err := r.client.variableAPI(ctx, http.MethodPut, variableID, plan.request())
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Empty(), err, response)
+		return
+	}
+
+	plan.populate(updated)
+	plan.ValueHash = types.StringValue(variableValueHash(plan.Value.ValueString()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *variableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state variableResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_variable to be deleted")
+	defer cancel()
+
+	variableID, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ID format",
+			err.Error(),
+		)
+		return
+	}
+
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*variable, *http.Response, error) {
+		return r.client.variableAPI(ctx, http.MethodDelete, variableID, nil)
+	}, 5)
+	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddAPIError(&resp.Diagnostics, err, response)
+		return
+	}
+}
+
+// ImportState imports a variable from its ID. The value of a secret variable
+// is not imported, so the first apply after an import sends it again.
+func (r *variableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// request returns the body of the create and update requests for m.
+func (m *variableResourceModel) request() *variableRequest {
+	return &variableRequest{
+		Key:    m.Key.ValueString(),
+		Value:  m.Value.ValueString(),
+		Secret: m.Secret.ValueBool(),
+	}
+}
+
+// populate sets the attributes of m the API returns for v, other than the
+// value, which the API does not return for a secret variable.
+func (m *variableResourceModel) populate(v *variable) {
+	m.ID = types.StringValue(strconv.FormatInt(v.ID, 10))
+	m.Key = types.StringValue(v.Key)
+	m.Secret = types.BoolValue(v.Secret)
+	m.LastEditor = types.StringValue(v.LastEditor)
+	m.LastModified = types.StringValue(v.LastModified.Format(time.RFC3339Nano))
+}
+
+// variableValueHash returns the hex encoded SHA-256 of value.
+func variableValueHash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// variablesPath is the path of the variables endpoint. The SDK has no
+// variables API, so variableAPI calls it directly.
+const variablesPath = "/workspace/variables"
+
+// variable is a variable as the API returns it. Value is empty for a secret
+// variable.
+type variable struct {
+	ID           int64     `json:"id"`
+	Key          string    `json:"key"`
+	Value        string    `json:"value"`
+	Secret       bool      `json:"secret"`
+	LastEditor   string    `json:"last_editor"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+// variableRequest is the body of the create and update requests.
+type variableRequest struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Secret bool   `json:"secret"`
+}
+
+// variableAPI sends a request to the variables endpoint, or to the variable
+// id when it is not zero, through the HTTP client of the SDK, so that it is
+// retried, paced and logged like the SDK requests. It returns the variable
+// in the response, if any. As with the SDK, an error response is returned
+// along with the error, with its body still readable for the API error to be
+// decoded from it.
+func (c *apiClient) variableAPI(ctx context.Context, method string, id int64, body *variableRequest) (*variable, *http.Response, error) {
+	url := c.apiConfig.Servers[0].URL + variablesPath
+	if id != 0 {
+		url += "/" + strconv.FormatInt(id, 10)
+	}
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range c.apiConfig.DefaultHeader {
+		httpReq.Header.Set(k, v)
+	}
+	httpReq.Header.Set("User-Agent", c.apiConfig.UserAgent)
+	httpReq.Header.Set("Accept", "application/json")
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := c.apiConfig.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	response, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, response, err
+	}
+	data, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return nil, response, err
+	}
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return nil, response, errors.New(response.Status)
+	}
+	if method == http.MethodDelete {
+		return nil, response, nil
+	}
+
+	var wrapper struct {
+		Data variable `json:"data"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return nil, response, err
+	}
+	return &wrapper.Data, response, nil
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccVariableConfig(key, value string, secret bool) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_variable" "test" {
+  key    = %q
+  value  = %q
+  secret = %t
+}
+`, key, value, secret)
+}
+
+func TestAccVariableResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_variable.test"
+	var id string
+	variablePath := func() string { return "/workspace/variables/" + id }
+	checkStored := func(want string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			object, ok := server.Get(variablePath())
+			if !ok || object["value"] != want {
+				return fmt.Errorf("variable %s = %v, want value %q", id, object, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariableConfig("REGION", "us-east", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "key", "REGION"),
+					resource.TestCheckResourceAttr(name, "value", "us-east"),
+					resource.TestCheckResourceAttr(name, "secret", "false"),
+					resource.TestCheckResourceAttr(name, "value_hash", variableValueHash("us-east")),
+					testAccCaptureAttr(name, "id", &id),
+					checkStored("us-east"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				// The value of a variable that is not secret is refreshed.
+				PreConfig: func() {
+					server.Patch(variablePath(), map[string]any{"value": "eu-west"})
+				},
+				Config:             testAccVariableConfig("REGION", "us-east", false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVariableConfig("REGION", "us-east", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttrSame(name, "id", &id),
+					checkStored("us-east"),
+				),
+			},
+			{
+				// Making it secret replaces the variable.
+				Config: testAccVariableConfig("API_TOKEN", "s3cr3t", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "secret", "true"),
+					resource.TestCheckResourceAttr(name, "value", "s3cr3t"),
+					resource.TestCheckResourceAttr(name, "value_hash", variableValueHash("s3cr3t")),
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+					checkStored("s3cr3t"),
+				),
+			},
+			{
+				// The API does not return the value of a secret, so it is not
+				// imported.
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "value", "value_hash"},
+			},
+			{
+				Config: testAccVariableConfig("API_TOKEN", "rotated", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "value_hash", variableValueHash("rotated")),
+					testAccCheckAttrSame(name, "id", &id),
+					checkStored("rotated"),
+				),
+			},
+			{
+				// A secret changed outside Terraform cannot be compared, but
+				// its modification plans sending the value again.
+				PreConfig: func() {
+					server.Patch(variablePath(), map[string]any{
+						"value":         "leaked",
+						"last_modified": time.Now().UTC().Add(time.Minute).Format(time.RFC3339Nano),
+					})
+				},
+				Config:             testAccVariableConfig("API_TOKEN", "rotated", true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVariableConfig("API_TOKEN", "rotated", true),
+				Check:  checkStored("rotated"),
+			},
+			testAccDeletedStep(t, server, testAccVariableConfig("API_TOKEN", "rotated", true), variablePath),
+		},
+	})
+}
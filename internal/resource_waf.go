@@ -2,13 +2,13 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,6 +36,7 @@ type WafResourceModel struct {
 	ID          types.String        `tfsdk:"id"`
 	LastUpdated types.String        `tfsdk:"last_updated"`
 	Result      *WafResourceResults `tfsdk:"result"`
+	Timeouts    timeouts.Value      `tfsdk:"timeouts"`
 }
 
 type WafResourceResults struct {
@@ -76,7 +77,7 @@ func (r *wafResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_waf"
 }
 
-func (r *wafResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *wafResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Creates a WAF (Web Application Firewall) resource.",
 		Attributes: map[string]schema.Attribute{
@@ -185,6 +186,14 @@ func (r *wafResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -203,6 +212,14 @@ func (r *wafResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_waf to be created")
+	defer cancel()
+
 	// Build the WAF request.
 	wafRequest := azionapi.NewWAFRequest(plan.Result.Name.ValueString())
 
@@ -223,37 +240,11 @@ func (r *wafResource) Create(ctx context.Context, req resource.CreateRequest, re
 	// Create the WAF.
 	wafResponse, response, err := r.client.api.WAFsAPI.CreateWaf(ctx).WAFRequest(*wafRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			wafResponse, response, err = utils.RetryOn429(func() (*azionapi.WAFResponse, *http.Response, error) {
-				return r.client.api.WAFsAPI.CreateWaf(ctx).WAFRequest(*wafRequest).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("result"), err, response)
+		return
 	}
 
 	if response != nil {
@@ -287,8 +278,21 @@ func (r *wafResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	// Save the state's engine_settings to preserve it if it was null.
-	stateEngineSettings := state.Result.EngineSettings
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_waf to be read")
+	defer cancel()
+
+	// Save the state's engine_settings to preserve it if it was null. On
+	// import there is no prior result, so everything the API returns is kept.
+	importing := state.Result == nil
+	var stateEngineSettings *WafEngineSettingsResourceModel
+	if !importing {
+		stateEngineSettings = state.Result.EngineSettings
+	}
 
 	var wafID int64
 	var err error
@@ -307,41 +311,15 @@ func (r *wafResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	wafResponse, response, err := r.client.api.WAFsAPI.RetrieveWaf(ctx, wafID).Execute()
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			wafResponse, response, err = utils.RetryOn429(func() (*azionapi.WAFResponse, *http.Response, error) {
-				return r.client.api.WAFsAPI.RetrieveWaf(ctx, wafID).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("result"), err, response)
+		return
 	}
 
 	if response != nil {
@@ -354,7 +332,7 @@ func (r *wafResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	// Only update engine_settings from API response if the state had it specified.
 	// This prevents Terraform from seeing an inconsistency when engine_settings was null in state.
-	if stateEngineSettings == nil {
+	if !importing && stateEngineSettings == nil {
 		state.Result.EngineSettings = nil
 	}
 
@@ -373,6 +351,14 @@ func (r *wafResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_waf to be updated")
+	defer cancel()
+
 	var state WafResourceModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -415,37 +401,11 @@ func (r *wafResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// Update the WAF.
 	wafResponse, response, err := r.client.api.WAFsAPI.UpdateWaf(ctx, wafID).WAFRequest(*wafRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			wafResponse, response, err = utils.RetryOn429(func() (*azionapi.WAFResponse, *http.Response, error) {
-				return r.client.api.WAFsAPI.UpdateWaf(ctx, wafID).WAFRequest(*wafRequest).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("result"), err, response)
+		return
 	}
 
 	if response != nil {
@@ -479,6 +439,14 @@ func (r *wafResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_waf to be deleted")
+	defer cancel()
+
 	var wafID int64
 	var err error
 	if state.ID.IsNull() {
@@ -494,7 +462,7 @@ func (r *wafResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		}
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (interface{}, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (interface{}, *http.Response, error) {
 		delResp, httpResp, e := r.client.api.WAFsAPI.DeleteWaf(ctx, wafID).Execute()
 		return delResp, httpResp, e
 	}, 5)
@@ -502,21 +470,13 @@ func (r *wafResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("result"), err, response)
 		return
 	}
 }
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccWafRuleSetConfig(waf, name, path string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_waf" "first" {
+  result = {
+    name   = "first"
+    active = true
+  }
+}
+
+resource "azion_waf" "second" {
+  result = {
+    name   = "second"
+    active = true
+  }
+}
+
+resource "azion_waf_rule_set" "test" {
+  waf_id = azion_waf.%s.id
+  result = {
+    name     = %q
+    rule_id  = 0
+    path     = %q
+    operator = "regex"
+    active   = true
+    conditions = [
+      {
+        condition = {
+          match          = "specific_query_string_value"
+          value          = "token"
+          condition_type = "specific_on_value"
+        }
+      }
+    ]
+  }
+}
+`, waf, name, path)
+}
+
+func TestAccWafRuleSetResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_waf_rule_set.test"
+	var wafID, id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafRuleSetConfig("first", "allow-token", "/api/.*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "result.name", "allow-token"),
+					resource.TestCheckResourceAttr(name, "result.path", "/api/.*"),
+					resource.TestCheckResourceAttr(name, "result.conditions.0.condition.value", "token"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID(name, "waf_id", "id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccWafRuleSetConfig("first", "allow-token-v2", "/v2/.*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "result.name", "allow-token-v2"),
+					resource.TestCheckResourceAttr(name, "result.path", "/v2/.*"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccWafRuleSetConfig("second", "allow-token-v2", "/v2/.*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "waf_id", "azion_waf.second", "id"),
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "waf_id", &wafID),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccWafRuleSetConfig("second", "allow-token-v2", "/v2/.*"), func() string {
+				return "/workspace/wafs/" + wafID + "/exceptions/" + id
+			}),
+		},
+	})
+}
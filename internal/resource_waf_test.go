@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccWafConfig(name, sensitivity string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_waf" "test" {
+  result = {
+    name   = %q
+    active = true
+    engine_settings = {
+      engine_version = "2021-Q3"
+      type           = "score"
+      attributes = {
+        rulesets = [1]
+        thresholds = [
+          {
+            threshold = {
+              threat      = "sql_injection"
+              sensitivity = %q
+            }
+          }
+        ]
+      }
+    }
+  }
+}
+`, name, sensitivity)
+}
+
+func TestAccWafResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_waf.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafConfig("shield", "medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "result.name", "shield"),
+					resource.TestCheckResourceAttr(name, "result.active", "true"),
+					resource.TestCheckResourceAttr(name, "result.engine_settings.attributes.thresholds.#", "1"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccWafConfig("shield-v2", "high"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "result.name", "shield-v2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "result.engine_settings.attributes.thresholds.*", map[string]string{
+						"threshold.sensitivity": "high",
+					}),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccWafConfig("shield-v2", "high"),
+				Taint:  []string{name},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccWafConfig("shield-v2", "high"), func() string {
+				return "/workspace/wafs/" + id
+			}),
+		},
+	})
+}
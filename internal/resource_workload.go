@@ -2,13 +2,13 @@ package provider
 
 import (
 	"context"
-	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,11 +36,13 @@ type workloadResourceModel struct {
 	Workload    *workloadResourceResults `tfsdk:"workload"`
 	ID          types.String             `tfsdk:"id"`
 	LastUpdated types.String             `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value           `tfsdk:"timeouts"`
 }
 
 type workloadResourceResults struct {
 	ID                        types.Int64               `tfsdk:"id"`
 	Name                      types.String              `tfsdk:"name"`
+	FullName                  types.String              `tfsdk:"full_name"`
 	Active                    types.Bool                `tfsdk:"active"`
 	LastEditor                types.String              `tfsdk:"last_editor"`
 	LastModified              types.String              `tfsdk:"last_modified"`
@@ -87,7 +89,7 @@ func (r *workloadResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_workload"
 }
 
-func (r *workloadResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *workloadResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Resource for managing Azion Workloads.",
 		Attributes: map[string]schema.Attribute{
@@ -112,6 +114,10 @@ func (r *workloadResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						Description: "Name of the workload.",
 						Required:    true,
 					},
+					"full_name": schema.StringAttribute{
+						Description: "Name of the workload in the API, which starts with the `default_name_prefix` of the provider.",
+						Computed:    true,
+					},
 					"active": schema.BoolAttribute{
 						Description: "Status of the workload.",
 						Optional:    true,
@@ -236,6 +242,14 @@ func (r *workloadResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -254,7 +268,15 @@ func (r *workloadResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	workload := azionapi.NewWorkloadRequest(plan.Workload.Name.ValueString())
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_workload to be created")
+	defer cancel()
+
+	workload := azionapi.NewWorkloadRequest(r.client.prefixName(plan.Workload.Name.ValueString()))
 
 	// Set optional fields
 	if !plan.Workload.Active.IsNull() && !plan.Workload.Active.IsUnknown() {
@@ -375,37 +397,11 @@ func (r *workloadResource) Create(ctx context.Context, req resource.CreateReques
 
 	createWorkload, response, err := r.client.api.WorkloadsAPI.CreateWorkload(ctx).WorkloadRequest(*workload).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			createWorkload, response, err = utils.RetryOn429(func() (*azionapi.WorkloadResponse, *http.Response, error) {
-				return r.client.api.WorkloadsAPI.CreateWorkload(ctx).WorkloadRequest(*workload).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("workload"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -413,6 +409,8 @@ func (r *workloadResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Populate the state from the response, preserving plan values for optional nested fields.
 	plan.Workload = populateWorkloadResults(ctx, createWorkload, plan.Workload)
+	plan.Workload.Name = types.StringValue(r.client.unprefixName(createWorkload.Data.Name))
+	plan.Workload.FullName = types.StringValue(createWorkload.Data.Name)
 	plan.ID = types.StringValue(strconv.FormatInt(createWorkload.Data.Id, 10))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -431,6 +429,14 @@ func (r *workloadResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_workload to be read")
+	defer cancel()
+
 	var workloadId int64
 	var err error
 	if state.Workload != nil {
@@ -448,47 +454,23 @@ func (r *workloadResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	getWorkload, response, err := r.client.api.WorkloadsAPI.RetrieveWorkload(ctx, workloadId).Execute()
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			getWorkload, response, err = utils.RetryOn429(func() (*azionapi.WorkloadResponse, *http.Response, error) {
-				return r.client.api.WorkloadsAPI.RetrieveWorkload(ctx, workloadId).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("workload"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
 	}
 
 	state.Workload = populateWorkloadResults(ctx, getWorkload, state.Workload)
+	state.Workload.Name = types.StringValue(r.client.unprefixName(getWorkload.Data.Name))
+	state.Workload.FullName = types.StringValue(getWorkload.Data.Name)
 	state.ID = types.StringValue(strconv.FormatInt(getWorkload.Data.Id, 10))
 
 	diags = resp.State.Set(ctx, &state)
@@ -506,6 +488,14 @@ func (r *workloadResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_workload to be updated")
+	defer cancel()
+
 	var state workloadResourceModel
 	diagsState := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diagsState...)
@@ -518,7 +508,7 @@ func (r *workloadResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Set optional fields
 	if !plan.Workload.Name.IsNull() && !plan.Workload.Name.IsUnknown() {
-		updateWorkloadRequest.SetName(plan.Workload.Name.ValueString())
+		updateWorkloadRequest.SetName(r.client.prefixName(plan.Workload.Name.ValueString()))
 	}
 
 	if !plan.Workload.Active.IsNull() && !plan.Workload.Active.IsUnknown() {
@@ -639,43 +629,19 @@ func (r *workloadResource) Update(ctx context.Context, req resource.UpdateReques
 
 	updateWorkload, response, err := r.client.api.WorkloadsAPI.PartialUpdateWorkload(ctx, workloadId).PatchedWorkloadRequest(*updateWorkloadRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			updateWorkload, response, err = utils.RetryOn429(func() (*azionapi.WorkloadResponse, *http.Response, error) {
-				return r.client.api.WorkloadsAPI.PartialUpdateWorkload(ctx, workloadId).PatchedWorkloadRequest(*updateWorkloadRequest).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("workload"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
 	}
 
 	plan.Workload = populateWorkloadResults(ctx, updateWorkload, plan.Workload)
+	plan.Workload.Name = types.StringValue(r.client.unprefixName(updateWorkload.Data.Name))
+	plan.Workload.FullName = types.StringValue(updateWorkload.Data.Name)
 	plan.ID = types.StringValue(strconv.FormatInt(updateWorkload.Data.Id, 10))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -694,30 +660,30 @@ func (r *workloadResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_workload to be deleted")
+	defer cancel()
+
 	workloadId := state.Workload.ID.ValueInt64()
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.WorkloadsAPI.DeleteWorkload(ctx, workloadId).Execute()
 	}, 5)
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
-		if response != nil && response.StatusCode == http.StatusNotFound {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return
 		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("workload"), err, response)
 		return
 	}
 }
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -11,8 +10,12 @@ import (
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -36,6 +39,7 @@ type WorkloadDeploymentResourceModel struct {
 	ID          types.String                       `tfsdk:"id"`
 	WorkloadID  types.Int64                        `tfsdk:"workload_id"`
 	LastUpdated types.String                       `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value                     `tfsdk:"timeouts"`
 }
 
 type WorkloadDeploymentResourceResults struct {
@@ -64,7 +68,7 @@ func (r *workloadDeploymentResource) Metadata(_ context.Context, req resource.Me
 	resp.TypeName = req.ProviderTypeName + "_workload_deployment"
 }
 
-func (r *workloadDeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *workloadDeploymentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Resource for managing Azion Workload Deployments.",
 		Attributes: map[string]schema.Attribute{
@@ -73,8 +77,11 @@ func (r *workloadDeploymentResource) Schema(_ context.Context, _ resource.Schema
 				Description: "Identifier of the resource (workloadID/deploymentID format).",
 			},
 			"workload_id": schema.Int64Attribute{
-				Description: "The workload identifier.",
+				Description: "The workload identifier. Changing it replaces the deployment.",
 				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update of the resource.",
@@ -145,6 +152,14 @@ func (r *workloadDeploymentResource) Schema(_ context.Context, _ resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -163,6 +178,14 @@ func (r *workloadDeploymentResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_workload_deployment to be created")
+	defer cancel()
+
 	// Build the strategy request
 	strategyAttrs := azionapi.NewDefaultDeploymentStrategyAttrsRequest(plan.Deployment.Strategy.Attributes.Application.ValueInt64())
 
@@ -201,35 +224,11 @@ func (r *workloadDeploymentResource) Create(ctx context.Context, req resource.Cr
 		CreateWorkloadDeployment(ctx, plan.WorkloadID.ValueInt64()).
 		WorkloadDeploymentRequest(*deploymentRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			createDeployment, response, err = utils.RetryOn429(func() (*azionapi.WorkloadDeploymentResponse, *http.Response, error) {
-				return r.client.api.WorkloadDeploymentsAPI.
-					CreateWorkloadDeployment(ctx, plan.WorkloadID.ValueInt64()).
-					WorkloadDeploymentRequest(*deploymentRequest).Execute()
-			}, 5)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("deployment"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -255,6 +254,14 @@ func (r *workloadDeploymentResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_workload_deployment to be read")
+	defer cancel()
+
 	var workloadID int64
 	var deploymentID int64
 
@@ -292,38 +299,15 @@ func (r *workloadDeploymentResource) Read(ctx context.Context, req resource.Read
 	deploymentResponse, response, err := r.client.api.WorkloadDeploymentsAPI.
 		RetrieveWorkloadDeployment(ctx, deploymentID, workloadID).Execute()
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			deploymentResponse, response, err = utils.RetryOn429(func() (*azionapi.WorkloadDeploymentResponse, *http.Response, error) {
-				return r.client.api.WorkloadDeploymentsAPI.
-					RetrieveWorkloadDeployment(ctx, deploymentID, workloadID).Execute()
-			}, 5)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("deployment"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -348,6 +332,14 @@ func (r *workloadDeploymentResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_workload_deployment to be updated")
+	defer cancel()
+
 	var state WorkloadDeploymentResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -409,35 +401,11 @@ func (r *workloadDeploymentResource) Update(ctx context.Context, req resource.Up
 		PartialUpdateWorkloadDeployment(ctx, deploymentID, plan.WorkloadID.ValueInt64()).
 		PatchedWorkloadDeploymentRequest(*patchedRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			updateResponse, response, err = utils.RetryOn429(func() (*azionapi.WorkloadDeploymentResponse, *http.Response, error) {
-				return r.client.api.WorkloadDeploymentsAPI.
-					PartialUpdateWorkloadDeployment(ctx, deploymentID, plan.WorkloadID.ValueInt64()).
-					PatchedWorkloadDeploymentRequest(*patchedRequest).Execute()
-			}, 5)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("deployment"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -462,6 +430,14 @@ func (r *workloadDeploymentResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_workload_deployment to be deleted")
+	defer cancel()
+
 	if state.Deployment == nil || state.Deployment.ID.IsNull() {
 		resp.Diagnostics.AddError(
 			"Deployment ID error",
@@ -478,7 +454,7 @@ func (r *workloadDeploymentResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.WorkloadDeploymentsAPI.
 			DeleteWorkloadDeployment(ctx, state.Deployment.ID.ValueInt64(), state.WorkloadID.ValueInt64()).Execute()
 	}, 5)
@@ -486,22 +462,14 @@ func (r *workloadDeploymentResource) Delete(ctx context.Context, req resource.De
 		defer response.Body.Close()
 	}
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		if response != nil && response.StatusCode == http.StatusNotFound {
 			// Resource already deleted, consider this a success
 			return
 		}
-		bodyBytes, errReadAll := io.ReadAll(response.Body)
-		if errReadAll != nil {
-			resp.Diagnostics.AddError(
-				errReadAll.Error(),
-				"err",
-			)
-		}
-		bodyString := string(bodyBytes)
-		resp.Diagnostics.AddError(
-			err.Error(),
-			bodyString,
-		)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("deployment"), err, response)
 		return
 	}
 }
@@ -539,34 +507,8 @@ func (r *workloadDeploymentResource) ImportState(ctx context.Context, req resour
 	deploymentResponse, response, err := r.client.api.WorkloadDeploymentsAPI.
 		RetrieveWorkloadDeployment(ctx, deploymentID, workloadID).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			deploymentResponse, response, err = utils.RetryOn429(func() (*azionapi.WorkloadDeploymentResponse, *http.Response, error) {
-				return r.client.api.WorkloadDeploymentsAPI.
-					RetrieveWorkloadDeployment(ctx, deploymentID, workloadID).Execute()
-			}, 5)
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(
-					errReadAll.Error(),
-					"err",
-				)
-			}
-			bodyString := string(bodyBytes)
-			resp.Diagnostics.AddError(
-				err.Error(),
-				bodyString,
-			)
-			return
-		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("deployment"), err, response)
+		return
 	}
 	if response != nil {
 		defer response.Body.Close()
@@ -576,6 +518,7 @@ func (r *workloadDeploymentResource) ImportState(ctx context.Context, req resour
 		WorkloadID: types.Int64Value(workloadID),
 		ID:         types.StringValue(req.ID),
 		Deployment: populateDeploymentResults(deploymentResponse),
+		Timeouts:   utils.NullTimeouts(),
 	}
 
 	diags := resp.State.Set(ctx, &state)
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccWorkloadDeploymentConfig(workload, name string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_application_main_setting" "test" {
+  application = {
+    name = "site"
+  }
+}
+
+resource "azion_firewall_main_setting" "test" {
+  data = {
+    name = "site"
+  }
+}
+
+resource "azion_workload" "first" {
+  workload = {
+    name = "first"
+  }
+}
+
+resource "azion_workload" "second" {
+  workload = {
+    name = "second"
+  }
+}
+
+resource "azion_workload_deployment" "test" {
+  workload_id = azion_workload.%s.workload.id
+  deployment = {
+    name = %q
+    strategy = {
+      type = "default"
+      attributes = {
+        application = azion_application_main_setting.test.application.application_id
+        firewall    = azion_firewall_main_setting.test.data.id
+      }
+    }
+  }
+}
+`, workload, name)
+}
+
+func TestAccWorkloadDeploymentResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_workload_deployment.test"
+	var workloadID, deploymentID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadDeploymentConfig("first", "production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "deployment.name", "production"),
+					resource.TestCheckResourceAttr(name, "deployment.current", "true"),
+					resource.TestCheckResourceAttrPair(name, "deployment.strategy.attributes.application", "azion_application_main_setting.test", "application.application_id"),
+					testAccCaptureAttr(name, "deployment.id", &deploymentID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportID(name, "workload_id", "deployment.id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccWorkloadDeploymentConfig("first", "production-v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "deployment.name", "production-v2"),
+					testAccCheckAttrSame(name, "deployment.id", &deploymentID),
+				),
+			},
+			{
+				Config: testAccWorkloadDeploymentConfig("second", "production-v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "workload_id", "azion_workload.second", "workload.id"),
+					testAccCheckAttrChanged(name, "deployment.id", &deploymentID),
+					testAccCaptureAttr(name, "workload_id", &workloadID),
+					testAccCaptureAttr(name, "deployment.id", &deploymentID),
+				),
+			},
+			testAccDeletedStep(t, server, testAccWorkloadDeploymentConfig("second", "production-v2"), func() string {
+				return "/workspace/workloads/" + workloadID + "/deployments/" + deploymentID
+			}),
+		},
+	})
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccWorkloadConfig(name, domains string) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_workload" "test" {
+  workload = {
+    name    = %q
+    domains = [%s]
+    protocols = {
+      http = {
+        versions    = ["http1", "http2"]
+        http_ports  = [80]
+        https_ports = [443]
+      }
+    }
+  }
+}
+`, name, domains)
+}
+
+func TestAccWorkloadResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_workload.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig("site", `"www.example.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "workload.name", "site"),
+					resource.TestCheckResourceAttr(name, "workload.active", "true"),
+					resource.TestCheckTypeSetElemAttr(name, "workload.domains.*", "www.example.com"),
+					resource.TestCheckResourceAttrSet(name, "workload.workload_domain"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccWorkloadConfig("site-v2", `"www.example.com", "api.example.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "workload.name", "site-v2"),
+					resource.TestCheckResourceAttr(name, "workload.domains.#", "2"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccWorkloadConfig("site-v2", `"www.example.com", "api.example.com"`),
+				Taint:  []string{name},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccWorkloadConfig("site-v2", `"www.example.com", "api.example.com"`), func() string {
+				return "/workspace/workloads/" + id
+			}),
+		},
+	})
+}
@@ -2,14 +2,13 @@ package provider
 
 import (
 	"context"
-	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	azionapi "github.com/aziontech/azionapi-v4-go-sdk-dev/azion-api"
 	"github.com/aziontech/terraform-provider-azion/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,9 +33,10 @@ type zoneResource struct {
 }
 
 type zoneResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	Zone        *zoneModel   `tfsdk:"zone"`
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Zone        *zoneModel     `tfsdk:"zone"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 type zoneModel struct {
@@ -52,7 +52,7 @@ func (r *zoneResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_intelligent_dns_zone"
 }
 
-func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *zoneResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -79,7 +79,10 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 					"domain": schema.StringAttribute{
 						Required:    true,
-						Description: "Domain name attributed by Azion to this configuration.",
+						Description: "Domain name attributed by Azion to this configuration. Changing it replaces the zone.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 					"active": schema.BoolAttribute{
 						Required:    true,
@@ -97,6 +100,14 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -116,6 +127,14 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, utils.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, createTimeout, "create", "azion_intelligent_dns_zone to be created")
+	defer cancel()
+
 	zoneRequest := azionapi.NewZoneRequest(
 		plan.Zone.Name.ValueString(),
 		plan.Zone.Domain.ValueString(),
@@ -125,34 +144,11 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 	zoneResponse, response, err := r.client.api.DNSZonesAPI.CreateDnsZone(ctx).
 		ZoneRequest(*zoneRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			zoneResponse, response, err = utils.RetryOn429(func() (*azionapi.ZoneResponse, *http.Response, error) {
-				return r.client.api.DNSZonesAPI.CreateDnsZone(ctx).
-					ZoneRequest(*zoneRequest).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			bodyBytes, errReadAll := io.ReadAll(response.Body)
-			if errReadAll != nil {
-				resp.Diagnostics.AddError(errReadAll.Error(), "err")
-				return
-			}
-			bodyString := string(bodyBytes)
-			usrMsg, errMsg := errPrintZoneResource(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, fmt.Sprintf("%s\nDetails: %s", errMsg, bodyString))
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("zone"), err, response)
+		return
 	}
 
 	if response != nil {
@@ -204,6 +200,14 @@ func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, utils.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, readTimeout, "read", "azion_intelligent_dns_zone to be read")
+	defer cancel()
+
 	zoneId, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -215,31 +219,15 @@ func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	zoneResponse, response, err := r.client.api.DNSZonesAPI.RetrieveDnsZone(ctx, zoneId).Execute()
 	if err != nil {
-		if response.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
-		if response.StatusCode == 429 {
-			zoneResponse, response, err = utils.RetryOn429(func() (*azionapi.ZoneResponse, *http.Response, error) {
-				return r.client.api.DNSZonesAPI.RetrieveDnsZone(ctx, zoneId).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintZoneResource(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("zone"), err, response)
+		return
 	}
 
 	if response != nil {
@@ -288,6 +276,14 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, utils.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, updateTimeout, "update", "azion_intelligent_dns_zone to be updated")
+	defer cancel()
+
 	zoneId, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -305,28 +301,11 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	zoneResponse, response, err := r.client.api.DNSZonesAPI.UpdateDnsZone(ctx, zoneId).
 		UpdateZoneRequest(*updateRequest).Execute()
 	if err != nil {
-		if response.StatusCode == 429 {
-			zoneResponse, response, err = utils.RetryOn429(func() (*azionapi.ZoneResponse, *http.Response, error) {
-				return r.client.api.DNSZonesAPI.UpdateDnsZone(ctx, zoneId).
-					UpdateZoneRequest(*updateRequest).Execute()
-			}, 5)
-
-			if response != nil {
-				defer response.Body.Close()
-			}
-
-			if err != nil {
-				resp.Diagnostics.AddError(
-					err.Error(),
-					"API request failed after too many retries",
-				)
-				return
-			}
-		} else {
-			usrMsg, errMsg := errPrintZoneResource(response.StatusCode, err)
-			resp.Diagnostics.AddError(usrMsg, errMsg)
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
 			return
 		}
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("zone"), err, response)
+		return
 	}
 
 	if response != nil {
@@ -377,6 +356,14 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, utils.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, deleteTimeout, "delete", "azion_intelligent_dns_zone to be deleted")
+	defer cancel()
+
 	zoneId, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -386,18 +373,20 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	_, response, err := utils.RetryOn429Delete(func() (*azionapi.DeleteResponse, *http.Response, error) {
+	_, response, err := utils.RetryDeleteWhileReferenced(ctx, func() (*azionapi.DeleteResponse, *http.Response, error) {
 		return r.client.api.DNSZonesAPI.DeleteDnsZone(ctx, zoneId).Execute()
 	}, 5)
 	if response != nil {
 		defer response.Body.Close()
 	}
 	if err != nil {
+		if utils.AddTimeoutError(ctx, &resp.Diagnostics) {
+			return
+		}
 		if response != nil && response.StatusCode == http.StatusNotFound {
 			return
 		}
-		usrMsg, errMsg := errPrintZoneResource(response.StatusCode, err)
-		resp.Diagnostics.AddError(usrMsg, errMsg)
+		utils.AddAPIErrorAt(&resp.Diagnostics, path.Root("zone"), err, response)
 		return
 	}
 }
@@ -405,22 +394,3 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *zoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
-
-func errPrintZoneResource(errCode int, err error) (string, string) {
-	var usrMsg string
-	switch errCode {
-	case 400:
-		usrMsg = "Bad Request"
-	case 401:
-		usrMsg = "Unauthorized Token"
-	case 404:
-		usrMsg = "Zone not found"
-	case 409:
-		usrMsg = "Conflict - Zone already exists"
-	default:
-		usrMsg = err.Error()
-	}
-
-	errMsg := fmt.Sprintf("%d - %s", errCode, usrMsg)
-	return usrMsg, errMsg
-}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccZoneConfig(name, domain string, active bool) string {
+	return mockProviderConfig + fmt.Sprintf(`
+resource "azion_intelligent_dns_zone" "test" {
+  zone = {
+    name   = %q
+    domain = %q
+    active = %t
+  }
+}
+`, name, domain, active)
+}
+
+func TestAccZoneResource(t *testing.T) {
+	server := testAccMockAPI(t)
+	const name = "azion_intelligent_dns_zone.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneConfig("example", "example.com", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone.name", "example"),
+					resource.TestCheckResourceAttr(name, "zone.domain", "example.com"),
+					resource.TestCheckResourceAttr(name, "zone.nameservers.#", "3"),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				Config: testAccZoneConfig("example-renamed", "example.com", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone.name", "example-renamed"),
+					resource.TestCheckResourceAttr(name, "zone.active", "false"),
+					testAccCheckAttrSame(name, "id", &id),
+				),
+			},
+			{
+				Config: testAccZoneConfig("example-renamed", "example.org", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone.domain", "example.org"),
+					testAccCheckAttrChanged(name, "id", &id),
+					testAccCaptureAttr(name, "id", &id),
+				),
+			},
+			testAccDeletedStep(t, server, testAccZoneConfig("example-renamed", "example.org", false), func() string {
+				return "/workspace/dns/zones/" + id
+			}),
+		},
+	})
+}
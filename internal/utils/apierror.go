@@ -0,0 +1,278 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// RequestIDHeader is the response header carrying the identifier the Azion
+// API assigns to each request.
+const RequestIDHeader = "X-Request-Id"
+
+// APIError is a decoded error response of the Azion v4 API.
+type APIError struct {
+	StatusCode int
+	RequestID  string
+	Errors     []APIErrorObject
+	// Body is the raw response body, kept for responses that are not an
+	// error envelope.
+	Body string
+}
+
+// APIErrorObject is one entry of the errors array of the v4 error envelope.
+// Field errors carry the JSON pointer of the rejected request field in
+// Source.Pointer.
+type APIErrorObject struct {
+	Status string         `json:"status"`
+	Code   string         `json:"code"`
+	Title  string         `json:"title"`
+	Detail string         `json:"detail"`
+	Source APIErrorSource `json:"source"`
+	Meta   map[string]any `json:"meta"`
+}
+
+// APIErrorSource references the part of the request that caused an error.
+type APIErrorSource struct {
+	Pointer   string `json:"pointer"`
+	Parameter string `json:"parameter"`
+	Header    string `json:"header"`
+}
+
+func (e *APIError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, obj := range e.Errors {
+		messages = append(messages, obj.message())
+	}
+	if len(messages) == 0 && e.Body != "" {
+		messages = append(messages, e.Body)
+	}
+	msg := fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if len(messages) > 0 {
+		msg += ": " + strings.Join(messages, "; ")
+	}
+	return msg
+}
+
+// DecodeAPIError decodes the error body of response. The body is left
+// readable for the caller. It understands the v4 error envelope
+// ({"errors": [...]}) as well as the older {"detail": "..."} and
+// {"field": ["message"]} bodies some endpoints still return. It returns nil
+// when response is nil.
+func DecodeAPIError(response *http.Response) *APIError {
+	if response == nil {
+		return nil
+	}
+	apiErr := &APIError{
+		StatusCode: response.StatusCode,
+		RequestID:  response.Header.Get(RequestIDHeader),
+	}
+	body := bufferBody(response)
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		apiErr.Body = strings.TrimSpace(string(body))
+		return apiErr
+	}
+
+	if errs, ok := raw["errors"]; ok {
+		if err := json.Unmarshal(errs, &apiErr.Errors); err != nil {
+			// Some endpoints return the errors as plain strings.
+			var messages []string
+			if json.Unmarshal(errs, &messages) != nil {
+				apiErr.Body = strings.TrimSpace(string(body))
+				return apiErr
+			}
+			for _, m := range messages {
+				apiErr.Errors = append(apiErr.Errors, APIErrorObject{Detail: m})
+			}
+		}
+	} else if detail, ok := raw["detail"]; ok {
+		var obj APIErrorObject
+		if json.Unmarshal(detail, &obj.Detail) != nil {
+			obj.Detail = string(detail)
+		}
+		apiErr.Errors = append(apiErr.Errors, obj)
+	} else {
+		apiErr.Errors = fieldErrors("", raw)
+	}
+
+	if len(apiErr.Errors) == 0 {
+		apiErr.Body = strings.TrimSpace(string(body))
+	}
+	if apiErr.RequestID == "" {
+		for _, obj := range apiErr.Errors {
+			if id, ok := obj.Meta["request_id"].(string); ok {
+				apiErr.RequestID = id
+				break
+			}
+		}
+	}
+	return apiErr
+}
+
+// fieldErrors converts a {"field": ["message"]} body, possibly nested, into
+// error objects pointing at each field.
+func fieldErrors(pointer string, raw map[string]json.RawMessage) []APIErrorObject {
+	keys := make([]string, 0, len(raw))
+	for k := range raw {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var objs []APIErrorObject
+	for _, k := range keys {
+		fieldPointer := pointer + "/" + k
+		var message string
+		var messages []string
+		var nested map[string]json.RawMessage
+		switch {
+		case json.Unmarshal(raw[k], &message) == nil:
+			messages = []string{message}
+		case json.Unmarshal(raw[k], &messages) == nil:
+		case json.Unmarshal(raw[k], &nested) == nil:
+			objs = append(objs, fieldErrors(fieldPointer, nested)...)
+			continue
+		default:
+			messages = []string{string(raw[k])}
+		}
+		objs = append(objs, APIErrorObject{
+			Detail: strings.Join(messages, " "),
+			Source: APIErrorSource{Pointer: fieldPointer},
+		})
+	}
+	return objs
+}
+
+// AddAPIError adds one diagnostic per error returned by a failed API call.
+// Field errors name the rejected field in their detail.
+func AddAPIError(diags *diag.Diagnostics, err error, response *http.Response) {
+	addAPIError(diags, nil, err, response)
+}
+
+// AddAPIErrorAt is AddAPIError for resources: field errors become attribute
+// errors on root plus the field's path, so Terraform points at the attribute
+// the API rejected.
+func AddAPIErrorAt(diags *diag.Diagnostics, root path.Path, err error, response *http.Response) {
+	addAPIError(diags, &root, err, response)
+}
+
+func addAPIError(diags *diag.Diagnostics, root *path.Path, err error, response *http.Response) {
+	var retryErr *RetryError
+	if errors.As(err, &retryErr) {
+		diags.Append(retryErr.Diagnostic())
+		return
+	}
+	if response == nil {
+		diags.AddError(errorSummary(err), "No response was received from the Azion API.")
+		return
+	}
+
+	apiErr := DecodeAPIError(response)
+	if len(apiErr.Errors) == 0 {
+		detail := apiErr.Body
+		if detail == "" {
+			detail = "The Azion API returned no error details."
+		}
+		diags.AddError(errorSummary(err), detail+apiErr.footer(APIErrorObject{}))
+		return
+	}
+
+	for _, obj := range apiErr.Errors {
+		summary := obj.Title
+		if summary == "" {
+			summary = errorSummary(err)
+		}
+		detail := obj.Detail
+		if detail == "" {
+			detail = obj.Title
+		}
+		if obj.Source.Pointer != "" && root != nil {
+			diags.AddAttributeError(pointerPath(*root, obj.Source.Pointer), summary, detail+apiErr.footer(obj))
+			continue
+		}
+		if field := obj.field(); field != "" {
+			detail = field + ": " + detail
+		}
+		diags.AddError(summary, detail+apiErr.footer(obj))
+	}
+}
+
+// footer lists the error code and request ID, which Azion support needs to
+// trace a failure.
+func (e *APIError) footer(obj APIErrorObject) string {
+	var lines []string
+	if obj.Code != "" {
+		lines = append(lines, "Error code: "+obj.Code)
+	}
+	if e.RequestID != "" {
+		lines = append(lines, "Request ID: "+e.RequestID)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n\n" + strings.Join(lines, "\n")
+}
+
+func (o APIErrorObject) message() string {
+	msg := o.Detail
+	if msg == "" {
+		msg = o.Title
+	}
+	if field := o.field(); field != "" {
+		msg = field + ": " + msg
+	}
+	return msg
+}
+
+// field names the part of the request the error refers to, if any.
+func (o APIErrorObject) field() string {
+	switch {
+	case o.Source.Pointer != "":
+		return strings.Join(pointerSegments(o.Source.Pointer), ".")
+	case o.Source.Parameter != "":
+		return o.Source.Parameter
+	case o.Source.Header != "":
+		return o.Source.Header
+	}
+	return ""
+}
+
+// pointerPath resolves a JSON pointer such as /origins/0/address below root.
+func pointerPath(root path.Path, pointer string) path.Path {
+	p := root
+	for _, segment := range pointerSegments(pointer) {
+		if index, err := strconv.Atoi(segment); err == nil {
+			p = p.AtListIndex(index)
+			continue
+		}
+		p = p.AtName(segment)
+	}
+	return p
+}
+
+func pointerSegments(pointer string) []string {
+	pointer = strings.TrimPrefix(pointer, "/")
+	if pointer == "" {
+		return nil
+	}
+	segments := strings.Split(pointer, "/")
+	for i, s := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(s, "~1", "/"), "~0", "~")
+	}
+	return segments
+}
+
+func errorSummary(err error) string {
+	if err == nil {
+		return "Azion API request failed"
+	}
+	return err.Error()
+}
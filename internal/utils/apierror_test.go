@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestDecodeAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantErrors []APIErrorObject
+		wantBody   string
+	}{
+		{
+			name: "v4 envelope",
+			body: `{"errors":[{"status":"400","code":"invalid_choice","title":"Invalid value","detail":"\"all\" is not a valid choice.","source":{"pointer":"/workloads_access"}}]}`,
+			wantErrors: []APIErrorObject{{
+				Status: "400",
+				Code:   "invalid_choice",
+				Title:  "Invalid value",
+				Detail: `"all" is not a valid choice.`,
+				Source: APIErrorSource{Pointer: "/workloads_access"},
+			}},
+		},
+		{
+			name:       "detail body",
+			body:       `{"detail":"Authentication credentials were not provided."}`,
+			wantErrors: []APIErrorObject{{Detail: "Authentication credentials were not provided."}},
+		},
+		{
+			name: "field errors",
+			body: `{"name":["This field is required."],"origin":{"address":"Enter a valid address."}}`,
+			wantErrors: []APIErrorObject{
+				{Detail: "This field is required.", Source: APIErrorSource{Pointer: "/name"}},
+				{Detail: "Enter a valid address.", Source: APIErrorSource{Pointer: "/origin/address"}},
+			},
+		},
+		{
+			name:     "not json",
+			body:     "<html>Bad Gateway</html>\n",
+			wantBody: "<html>Bad Gateway</html>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := apiResponse(http.StatusBadRequest, tt.body)
+			apiErr := DecodeAPIError(response)
+
+			if len(apiErr.Errors) != len(tt.wantErrors) {
+				t.Fatalf("got %d errors, want %d: %+v", len(apiErr.Errors), len(tt.wantErrors), apiErr.Errors)
+			}
+			for i, want := range tt.wantErrors {
+				got := apiErr.Errors[i]
+				if got.Status != want.Status || got.Code != want.Code || got.Title != want.Title ||
+					got.Detail != want.Detail || got.Source != want.Source {
+					t.Errorf("error %d = %+v, want %+v", i, got, want)
+				}
+			}
+			if apiErr.Body != tt.wantBody {
+				t.Errorf("body = %q, want %q", apiErr.Body, tt.wantBody)
+			}
+			if again := bufferBody(response); string(again) != tt.body {
+				t.Errorf("body was not left readable, got %q", again)
+			}
+		})
+	}
+}
+
+func TestAddAPIErrorAt(t *testing.T) {
+	response := apiResponse(http.StatusBadRequest,
+		`{"errors":[{"code":"invalid_choice","title":"Invalid value","detail":"Not a valid choice.","source":{"pointer":"/workloads_access"}},{"title":"Quota exceeded","detail":"Too many buckets."}]}`)
+	response.Header = http.Header{RequestIDHeader: []string{"req-123"}}
+
+	var diags diag.Diagnostics
+	AddAPIErrorAt(&diags, path.Root("bucket"), errors.New("400 Bad Request"), response)
+
+	if len(diags) != 2 {
+		t.Fatalf("got %d diagnostics, want 2", len(diags))
+	}
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("field error is not an attribute diagnostic: %#v", diags[0])
+	}
+	if want := path.Root("bucket").AtName("workloads_access"); !withPath.Path().Equal(want) {
+		t.Errorf("path = %s, want %s", withPath.Path(), want)
+	}
+	if diags[0].Summary() != "Invalid value" {
+		t.Errorf("summary = %q", diags[0].Summary())
+	}
+	for _, want := range []string{"Not a valid choice.", "Error code: invalid_choice", "Request ID: req-123"} {
+		if !strings.Contains(diags[0].Detail(), want) {
+			t.Errorf("detail %q does not contain %q", diags[0].Detail(), want)
+		}
+	}
+	if _, ok := diags[1].(diag.DiagnosticWithPath); ok {
+		t.Errorf("error without a source was reported on an attribute")
+	}
+}
+
+func TestAddAPIErrorWithoutResponse(t *testing.T) {
+	var diags diag.Diagnostics
+	AddAPIError(&diags, errors.New("dial tcp: connection refused"), nil)
+
+	if len(diags) != 1 || diags[0].Summary() != "dial tcp: connection refused" {
+		t.Fatalf("diagnostics = %v", diags)
+	}
+}
+
+func TestPointerPath(t *testing.T) {
+	got := pointerPath(path.Root("connector"), "/attributes/addresses/1/address")
+	want := path.Root("connector").AtName("attributes").AtName("addresses").AtListIndex(1).AtName("address")
+	if !got.Equal(want) {
+		t.Errorf("pointerPath = %s, want %s", got, want)
+	}
+}
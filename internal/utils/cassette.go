@@ -0,0 +1,248 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	// CassetteModeRecord sends the requests to the API and saves them, with
+	// their responses, to the cassette.
+	CassetteModeRecord = "record"
+	// CassetteModeReplay serves the responses saved in the cassette without
+	// sending any request.
+	CassetteModeReplay = "replay"
+)
+
+// cassetteHeaders are the only response headers saved in a cassette.
+var cassetteHeaders = []string{"Content-Type", "Retry-After"}
+
+// openCassettes holds the transports opened by OpenCassette, by path.
+var openCassettes = struct {
+	sync.Mutex
+	transports map[string]*CassetteTransport
+}{transports: map[string]*CassetteTransport{}}
+
+// Cassette holds the interactions recorded from the API.
+type Cassette struct {
+	Interactions []CassetteInteraction `json:"interactions"`
+}
+
+// CassetteInteraction is a request and the response the API gave to it.
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest identifies a recorded request. URL is the path and query of
+// the request relative to the API URL, without its /v4 base path, so that
+// cassettes replay against any endpoint; headers are not recorded, which keeps
+// the API token out of the cassette.
+type CassetteRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response.
+type CassetteResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+// CassetteTransport is an http.RoundTripper that records the API
+// interactions to a cassette file, or replays them from it, so that tests can
+// run against real API responses without credentials.
+type CassetteTransport struct {
+	Base http.RoundTripper
+	Mode string
+	Path string
+	// BasePath is the path of the API URL, trimmed from the recorded URLs.
+	BasePath string
+
+	mu       sync.Mutex
+	cassette Cassette
+	// used marks the interactions already replayed.
+	used []bool
+}
+
+// NewCassetteTransport returns a transport for the cassette at path in mode,
+// either CassetteModeRecord or CassetteModeReplay, for the requests sent to
+// apiURL. Recording starts a new cassette; replaying loads the existing one. A
+// nil base uses http.DefaultTransport.
+func NewCassetteTransport(base http.RoundTripper, apiURL, mode, path string) (*CassetteTransport, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	parsed, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, err
+	}
+	t := &CassetteTransport{
+		Base:     base,
+		Mode:     mode,
+		Path:     path,
+		BasePath: strings.TrimSuffix(parsed.Path, "/"),
+	}
+	switch mode {
+	case CassetteModeRecord:
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+		return t, t.save()
+	case CassetteModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &t.cassette); err != nil {
+			return nil, fmt.Errorf("could not decode cassette %s: %w", path, err)
+		}
+		t.used = make([]bool, len(t.cassette.Interactions))
+		return t, nil
+	}
+	return nil, fmt.Errorf("unknown cassette mode %q, expected %q or %q", mode, CassetteModeRecord, CassetteModeReplay)
+}
+
+// OpenCassette returns the transport already open for the cassette at path in
+// mode, or opens a new one with NewCassetteTransport. The provider is
+// configured again for every Terraform command, and sharing the transport lets
+// a test record or replay all of its commands in one cassette.
+func OpenCassette(base http.RoundTripper, apiURL, mode, path string) (*CassetteTransport, error) {
+	openCassettes.Lock()
+	defer openCassettes.Unlock()
+	if t, ok := openCassettes.transports[path]; ok && t.Mode == mode {
+		return t, nil
+	}
+	t, err := NewCassetteTransport(base, apiURL, mode, path)
+	if err != nil {
+		return nil, err
+	}
+	openCassettes.transports[path] = t
+	return t, nil
+}
+
+// CloseCassette forgets the transport opened for the cassette at path, so
+// that the next OpenCassette starts over.
+func CloseCassette(path string) {
+	openCassettes.Lock()
+	defer openCassettes.Unlock()
+	delete(openCassettes.transports, path)
+}
+
+func (t *CassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, request, err := t.newCassetteRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if t.Mode == CassetteModeReplay {
+		return t.replay(req, request)
+	}
+
+	response, err := t.Base.RoundTrip(req)
+	if err != nil {
+		return response, err
+	}
+	body := bufferBody(response)
+	headers := map[string]string{}
+	for _, name := range cassetteHeaders {
+		if value := response.Header.Get(name); value != "" {
+			headers[name] = value
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cassette.Interactions = append(t.cassette.Interactions, CassetteInteraction{
+		Request: request,
+		Response: CassetteResponse{
+			Status:  response.StatusCode,
+			Headers: headers,
+			Body:    RedactBody(body),
+		},
+	})
+	return response, t.save()
+}
+
+// replay returns the response of the first recorded interaction matching
+// request that was not replayed yet.
+func (t *CassetteTransport) replay(req *http.Request, request CassetteRequest) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, interaction := range t.cassette.Interactions {
+		if t.used[i] || interaction.Request != request {
+			continue
+		}
+		t.used[i] = true
+
+		header := http.Header{}
+		for name, value := range interaction.Response.Headers {
+			header.Set(name, value)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("cassette %s has no unplayed interaction for %s %s", t.Path, request.Method, request.URL)
+}
+
+// save writes the cassette, replacing the file so that an interrupted test
+// leaves the interactions recorded so far.
+func (t *CassetteTransport) save() error {
+	data, err := json.MarshalIndent(t.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := t.Path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, t.Path)
+}
+
+// newCassetteRequest identifies req by its method, URL and redacted body. The
+// body is re-encoded by RedactBody, so that it compares equal regardless of
+// formatting. Reading the body consumes it, so newCassetteRequest returns a
+// clone of req with a fresh body to send instead, leaving req untouched.
+func (t *CassetteTransport) newCassetteRequest(req *http.Request) (*http.Request, CassetteRequest, error) {
+	uri := req.URL.RequestURI()
+	if t.BasePath != "" && strings.HasPrefix(uri, t.BasePath+"/") {
+		uri = strings.TrimPrefix(uri, t.BasePath)
+	}
+	request := CassetteRequest{
+		Method: req.Method,
+		URL:    uri,
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, request, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return req, request, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+	clone.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	request.Body = RedactBody(body)
+	return clone, request, nil
+}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCassetteTransport(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"id":1,"name":"origin","secret_key":"s3cr3t"}}`)
+		default:
+			_, _ = io.WriteString(w, `{"data":{"id":1,"name":"origin","version":`+strconv.Itoa(calls)+`}}`)
+		}
+	}))
+	path := filepath.Join(t.TempDir(), "cassettes", "connector.json")
+
+	send := func(client *http.Client, method, body string) (int, string) {
+		t.Helper()
+		var reader io.Reader
+		if body != "" {
+			reader = strings.NewReader(body)
+		}
+		req, err := http.NewRequest(method, server.URL+"/v4/workspace/connectors?page=1", reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "token t0k3n")
+		response, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		defer response.Body.Close()
+		data, _ := io.ReadAll(response.Body)
+		return response.StatusCode, string(data)
+	}
+
+	recorder, err := NewCassetteTransport(nil, server.URL+"/v4", CassetteModeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: recorder}
+	status, created := send(client, http.MethodPost, `{"name": "origin", "secret_key": "s3cr3t"}`)
+	if status != http.StatusCreated || !strings.Contains(created, "s3cr3t") {
+		t.Errorf("recorded POST = %d %s, want the API response", status, created)
+	}
+	_, first := send(client, http.MethodGet, "")
+	_, second := send(client, http.MethodGet, "")
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"s3cr3t", "t0k3n", "session=abc", "127.0.0.1"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+	if !strings.Contains(string(data), `"url": "/workspace/connectors?page=1"`) {
+		t.Errorf("cassette URLs are not relative to the API URL:\n%s", data)
+	}
+
+	player, err := NewCassetteTransport(nil, server.URL+"/v4", CassetteModeReplay, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = &http.Client{Transport: player}
+	// The body matches whatever its formatting and sensitive values.
+	status, replayed := send(client, http.MethodPost, `{"secret_key":"other","name":"origin"}`)
+	if status != http.StatusCreated || !strings.Contains(replayed, `"name":"origin"`) || strings.Contains(replayed, "s3cr3t") {
+		t.Errorf("replayed POST = %d %s", status, replayed)
+	}
+	if _, got := send(client, http.MethodGet, ""); got != first {
+		t.Errorf("first replayed GET = %s, want %s", got, first)
+	}
+	if _, got := send(client, http.MethodGet, ""); got != second {
+		t.Errorf("second replayed GET = %s, want %s", got, second)
+	}
+	if calls != 3 {
+		t.Errorf("server calls = %d, want 3", calls)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com/v4/workspace/connectors?page=1", nil)
+	if _, err := player.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "no unplayed interaction") {
+		t.Errorf("GET after the cassette ran out error = %v", err)
+	}
+}
+
+func TestCassetteTransportLeavesRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+	recorder, err := NewCassetteTransport(nil, server.URL, CassetteModeRecord, filepath.Join(t.TempDir(), "echo.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := io.NopCloser(strings.NewReader(`{"name":"origin"}`))
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/workspace/connectors", body)
+	response, err := recorder.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	if data, _ := io.ReadAll(response.Body); string(data) != `{"name":"origin"}` {
+		t.Errorf("server received %q", data)
+	}
+	if req.Body != body {
+		t.Error("RoundTrip replaced the body of the request it was given")
+	}
+}
+
+func TestNewCassetteTransportErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewCassetteTransport(nil, "", "rewind", filepath.Join(dir, "cassette.json")); err == nil {
+		t.Error("unknown mode did not fail")
+	}
+	if _, err := NewCassetteTransport(nil, "", CassetteModeReplay, filepath.Join(dir, "missing.json")); !os.IsNotExist(err) {
+		t.Errorf("missing cassette error = %v, want not exist", err)
+	}
+	invalid := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(invalid, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewCassetteTransport(nil, "", CassetteModeReplay, invalid); err == nil {
+		t.Error("invalid cassette did not fail")
+	}
+}
+
+func TestOpenCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	t.Cleanup(func() { CloseCassette(path) })
+
+	first, err := OpenCassette(nil, "", CassetteModeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := OpenCassette(nil, "", CassetteModeRecord, path); again != first {
+		t.Error("OpenCassette did not reuse the open transport")
+	}
+	if replay, _ := OpenCassette(nil, "", CassetteModeReplay, path); replay == first {
+		t.Error("OpenCassette reused the transport in another mode")
+	}
+	CloseCassette(path)
+	if again, _ := OpenCassette(nil, "", CassetteModeReplay, path); again == nil || again.Mode != CassetteModeReplay {
+		t.Errorf("OpenCassette after CloseCassette = %v", again)
+	}
+}
@@ -0,0 +1,248 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxLoggedBody caps the size of request and response bodies read for the
+// TRACE log. Larger bodies are not logged, since a JSON document cut short
+// could not be parsed to mask its credentials.
+const maxLoggedBody = 64 * 1024
+
+// traceLogEnvs are the environment variables Terraform takes the log level of
+// the provider from, most specific first.
+var traceLogEnvs = []string{"TF_LOG_PROVIDER_AZION", "TF_LOG_PROVIDER", "TF_LOG"}
+
+const redacted = "***"
+
+// sensitiveHeaders are masked in logged requests and responses.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"Proxy-Authorization",
+}
+
+// sensitiveFields are JSON keys whose values are masked in logged bodies,
+// wherever they appear in the document.
+var sensitiveFields = map[string]bool{
+	"access_key":        true,
+	"api_token":         true,
+	"client_secret":     true,
+	"password":          true,
+	"private_key":       true,
+	"secret":            true,
+	"secret_access_key": true,
+	"secret_key":        true,
+	"token":             true,
+}
+
+type attemptKey struct{}
+
+// withAttempt records the retry attempt of a request in its context, so that
+// the transports below RetryTransport can report it.
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// RetryAttempt returns the zero-based retry attempt of the request carrying
+// ctx.
+func RetryAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(attemptKey{}).(int)
+	return attempt
+}
+
+// LoggingTransport is an http.RoundTripper that logs every API call through
+// tflog: method, URL, status, latency, retry attempt and Azion request ID at
+// DEBUG, and the headers and JSON bodies at TRACE. Bodies are only read when
+// TRACE logs are enabled, and credentials are masked before anything is
+// logged.
+type LoggingTransport struct {
+	Base http.RoundTripper
+}
+
+// NewLoggingTransport wraps base. A nil base uses http.DefaultTransport.
+func NewLoggingTransport(base http.RoundTripper) *LoggingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &LoggingTransport{Base: base}
+}
+
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	fields := map[string]any{
+		"http_method": req.Method,
+		"http_url":    req.URL.Redacted(),
+		"attempt":     RetryAttempt(ctx) + 1,
+	}
+	trace := traceLogging()
+
+	tflog.Debug(ctx, "Sending Azion API request", fields)
+	if trace {
+		body, err := requestBody(req)
+		if err != nil {
+			return nil, err
+		}
+		tflog.Trace(ctx, "Azion API request details", map[string]any{
+			"http_headers": RedactHeaders(req.Header),
+			"http_body":    body,
+		})
+	}
+
+	start := time.Now()
+	response, err := t.Base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Azion API request failed", fields)
+		return response, err
+	}
+
+	fields["http_status"] = response.StatusCode
+	if requestID := response.Header.Get(RequestIDHeader); requestID != "" {
+		fields["request_id"] = requestID
+	}
+	tflog.Debug(ctx, "Received Azion API response", fields)
+	if trace {
+		body, err := responseBody(response)
+		if err != nil {
+			return nil, err
+		}
+		tflog.Trace(ctx, "Azion API response details", map[string]any{
+			"http_headers": RedactHeaders(response.Header),
+			"http_body":    body,
+		})
+	}
+	return response, nil
+}
+
+// traceLogging reports whether Terraform writes the TRACE logs of the
+// provider. The provider logger itself accepts every level and leaves the
+// filtering to Terraform, so the environment is the only way to know.
+func traceLogging() bool {
+	for _, name := range traceLogEnvs {
+		if level := strings.ToUpper(os.Getenv(name)); level != "" {
+			return level == "TRACE" || level == "JSON"
+		}
+	}
+	return false
+}
+
+// isJSON reports whether header describes a JSON body. Other bodies, such as
+// the files of a storage bucket, are never logged.
+func isJSON(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// requestBody returns a redacted copy of a JSON request body, leaving the
+// original untouched.
+func requestBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil || !isJSON(req.Header) {
+		return "", nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return "", fmt.Errorf("reading the request body for the log: %w", err)
+	}
+	defer body.Close()
+	data, err := io.ReadAll(io.LimitReader(body, maxLoggedBody+1))
+	if err != nil {
+		return "", fmt.Errorf("reading the request body for the log: %w", err)
+	}
+	return redactLoggedBody(data), nil
+}
+
+// responseBody returns a redacted copy of a JSON response body, reading at
+// most maxLoggedBody bytes of it. The body is restored for the caller, and it
+// is closed if it cannot be read.
+func responseBody(response *http.Response) (string, error) {
+	if response.Body == nil || response.Body == http.NoBody || !isJSON(response.Header) {
+		return "", nil
+	}
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxLoggedBody+1))
+	if err != nil {
+		response.Body.Close()
+		return "", fmt.Errorf("reading the response body: %w", err)
+	}
+	response.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), response.Body), response.Body}
+	return redactLoggedBody(data), nil
+}
+
+// redactLoggedBody masks the credentials in a body read for the log, which
+// is left out when it was cut at maxLoggedBody.
+func redactLoggedBody(data []byte) string {
+	if len(data) > maxLoggedBody {
+		return fmt.Sprintf("(more than %d bytes, not logged)", maxLoggedBody)
+	}
+	return RedactBody(data)
+}
+
+// RedactHeaders returns a copy of header with credentials masked.
+func RedactHeaders(header http.Header) http.Header {
+	out := header.Clone()
+	for _, name := range sensitiveHeaders {
+		if out.Get(name) != "" {
+			out.Set(name, redacted)
+		}
+	}
+	return out
+}
+
+// RedactBody masks the values of sensitive fields in a JSON body. Bodies that
+// are not JSON are returned unchanged, except for PEM private keys.
+func RedactBody(body []byte) string {
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return redactPEM(string(body))
+	}
+	out, err := json.Marshal(redactValue(doc))
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if sensitiveFields[strings.ToLower(key)] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(field)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	case string:
+		return redactPEM(v)
+	}
+	return value
+}
+
+// privateKeyPEM matches PEM encoded private keys, which can show up in fields
+// other than private_key, such as a certificate bundle.
+var privateKeyPEM = regexp.MustCompile(`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?-----END [A-Z ]*PRIVATE KEY-----`)
+
+func redactPEM(s string) string {
+	return privateKeyPEM.ReplaceAllString(s, redacted)
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// ListPageSize is the page size used to walk every page of a list.
+const ListPageSize int64 = 100
+
+// ListPage is the v4 list envelope: the total count of items, the number of
+// pages and the results of one page. The SDK paginated list models implement
+// it.
+type ListPage[T any] interface {
+	GetCount() int64
+	GetTotalPages() int64
+	GetResults() []T
+	SetResults(v []T)
+}
+
+// ListFetch fetches the page of a list, counted from 1, with pageSize items.
+type ListFetch[P any] func(page, pageSize int64) (P, *http.Response, error)
+
+// ListPageError is returned when fetching a page of a list fails.
+type ListPageError struct {
+	// Page is the page that could not be fetched.
+	Page int64
+	// Err is the error returned by the API call.
+	Err error
+	// Response is the API response, with its body still readable.
+	Response *http.Response
+}
+
+func (e *ListPageError) Error() string {
+	return fmt.Sprintf("could not fetch page %d: %s", e.Page, e.Err)
+}
+
+func (e *ListPageError) Unwrap() error {
+	return e.Err
+}
+
+// ListPages returns an iterator over the pages of a list, from the first one
+// until total_pages or the first page without results. A failed page is
+// yielded with a *ListPageError and ends the iteration, as does ctx being
+// cancelled. The bodies of the pages fetched successfully are closed.
+func ListPages[T any, P ListPage[T]](ctx context.Context, fetch ListFetch[P]) iter.Seq2[P, error] {
+	return func(yield func(P, error) bool) {
+		for page := int64(1); ; page++ {
+			if err := ctx.Err(); err != nil {
+				var zero P
+				yield(zero, &ListPageError{Page: page, Err: err})
+				return
+			}
+			list, response, err := fetch(page, ListPageSize)
+			if err != nil {
+				yield(list, &ListPageError{Page: page, Err: err, Response: response})
+				return
+			}
+			if response != nil && response.Body != nil {
+				response.Body.Close()
+			}
+			if !yield(list, nil) {
+				return
+			}
+			if len(list.GetResults()) == 0 || page >= list.GetTotalPages() {
+				return
+			}
+		}
+	}
+}
+
+// ListAll returns an iterator over the results of every page of a list.
+func ListAll[T any, P ListPage[T]](ctx context.Context, fetch ListFetch[P]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for list, err := range ListPages[T](ctx, fetch) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, result := range list.GetResults() {
+				if !yield(result, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// FetchAll walks every page of a list and returns the last page holding the
+// results of all of them, so that data sources fill their state from it as
+// from a single page. On failure it returns the response of the page that
+// failed, for the API error to be decoded from it.
+func FetchAll[T any, P ListPage[T]](ctx context.Context, fetch ListFetch[P]) (P, *http.Response, error) {
+	var last P
+	var results []T
+	for list, err := range ListPages[T](ctx, fetch) {
+		if err != nil {
+			var zero P
+			var pageErr *ListPageError
+			if errors.As(err, &pageErr) {
+				return zero, pageErr.Response, err
+			}
+			return zero, nil, err
+		}
+		last = list
+		results = append(results, list.GetResults()...)
+	}
+	last.SetResults(results)
+	return last, nil, nil
+}
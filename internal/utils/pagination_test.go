@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type testPage struct {
+	totalPages int64
+	results    []int
+}
+
+func (p *testPage) GetCount() int64      { return int64(len(p.results)) }
+func (p *testPage) GetTotalPages() int64 { return p.totalPages }
+func (p *testPage) GetResults() []int    { return p.results }
+func (p *testPage) SetResults(v []int)   { p.results = v }
+
+// testList serves items in pages of pageSize, recording the pages fetched.
+func testList(items []int, fetched *[]int64) ListFetch[*testPage] {
+	return func(page, pageSize int64) (*testPage, *http.Response, error) {
+		*fetched = append(*fetched, page)
+		totalPages := (int64(len(items)) + pageSize - 1) / pageSize
+		start := min((page-1)*pageSize, int64(len(items)))
+		end := min(page*pageSize, int64(len(items)))
+		return &testPage{totalPages: totalPages, results: items[start:end]}, apiResponse(http.StatusOK, "{}"), nil
+	}
+}
+
+func TestListAll(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       int
+		wantFetched []int64
+	}{
+		{name: "empty", items: 0, wantFetched: []int64{1}},
+		{name: "one page", items: 3, wantFetched: []int64{1}},
+		{name: "full page", items: int(ListPageSize), wantFetched: []int64{1}},
+		{name: "several pages", items: int(ListPageSize)*2 + 1, wantFetched: []int64{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := make([]int, tt.items)
+			for i := range items {
+				items[i] = i
+			}
+
+			var fetched []int64
+			var got []int
+			for item, err := range ListAll[int](context.Background(), testList(items, &fetched)) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, item)
+			}
+			if !slices.Equal(got, items) {
+				t.Errorf("ListAll() returned %d items, want %d", len(got), len(items))
+			}
+			if !slices.Equal(fetched, tt.wantFetched) {
+				t.Errorf("fetched pages %v, want %v", fetched, tt.wantFetched)
+			}
+
+			fetched = nil
+			last, _, err := FetchAll[int](context.Background(), testList(items, &fetched))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(last.GetResults()) != tt.items {
+				t.Errorf("FetchAll() returned %d results, want %d", len(last.GetResults()), tt.items)
+			}
+		})
+	}
+}
+
+func TestListAllStopsEarly(t *testing.T) {
+	items := make([]int, ListPageSize*3)
+	var fetched []int64
+	for item := range ListAll[int](context.Background(), testList(items, &fetched)) {
+		if item == 0 {
+			break
+		}
+	}
+	if !slices.Equal(fetched, []int64{1}) {
+		t.Errorf("fetched pages %v after breaking out, want [1]", fetched)
+	}
+}
+
+func TestListAllStopsOnEmptyPage(t *testing.T) {
+	// A total_pages larger than the pages with results does not loop forever.
+	calls := 0
+	fetch := func(page, pageSize int64) (*testPage, *http.Response, error) {
+		calls++
+		if page > 1 {
+			return &testPage{totalPages: 1000}, nil, nil
+		}
+		return &testPage{totalPages: 1000, results: []int{1}}, nil, nil
+	}
+	last, _, err := FetchAll[int](context.Background(), fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || len(last.GetResults()) != 1 {
+		t.Errorf("calls = %d, results = %v, want 2 calls and 1 result", calls, last.GetResults())
+	}
+}
+
+func TestFetchAllError(t *testing.T) {
+	fetch := func(page, pageSize int64) (*testPage, *http.Response, error) {
+		if page == 2 {
+			return nil, apiResponse(http.StatusBadRequest, `{"detail":"invalid page"}`), errors.New("400 Bad Request")
+		}
+		return &testPage{totalPages: 3, results: []int{1}}, apiResponse(http.StatusOK, "{}"), nil
+	}
+	_, response, err := FetchAll[int](context.Background(), fetch)
+
+	var pageErr *ListPageError
+	if !errors.As(err, &pageErr) || pageErr.Page != 2 {
+		t.Fatalf("err = %v, want a *ListPageError for page 2", err)
+	}
+	if !strings.Contains(err.Error(), "400 Bad Request") {
+		t.Errorf("err = %q, want the API error", err)
+	}
+	if body, _ := io.ReadAll(response.Body); string(body) != `{"detail":"invalid page"}` {
+		t.Errorf("response body = %q, want it readable", body)
+	}
+}
+
+func TestFetchAllCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var fetched []int64
+	_, _, err := FetchAll[int](ctx, testList([]int{1}, &fetched))
+	if !errors.Is(err, context.Canceled) || len(fetched) != 0 {
+		t.Errorf("err = %v, fetched = %v, want context.Canceled and no fetch", err, fetched)
+	}
+}
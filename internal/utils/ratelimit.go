@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitTransport is an http.RoundTripper that waits on a shared token
+// bucket before sending each request, so that concurrent resources pace
+// themselves instead of waiting for the API to answer with a 429.
+type RateLimitTransport struct {
+	Base    http.RoundTripper
+	Limiter *rate.Limiter
+}
+
+// NewRateLimiter returns a token bucket allowing requestsPerSecond requests
+// per second with bursts of up to burst requests. A non-positive
+// requestsPerSecond disables rate limiting.
+func NewRateLimiter(requestsPerSecond float64, burst int) *rate.Limiter {
+	if requestsPerSecond <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(requestsPerSecond), max(burst, 1))
+}
+
+// NewRateLimitTransport wraps base with limiter. A nil base uses
+// http.DefaultTransport.
+func NewRateLimitTransport(base http.RoundTripper, limiter *rate.Limiter) *RateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RateLimitTransport{
+		Base:    base,
+		Limiter: limiter,
+	}
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.Limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.Base.RoundTrip(req)
+}
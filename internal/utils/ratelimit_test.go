@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimitTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRateLimitTransport(nil, NewRateLimiter(20, 2))}
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		response, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+	}
+
+	// The first two requests use the burst; the remaining four are paced at
+	// one every 50ms.
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("6 requests took %s, want at least 180ms", elapsed)
+	}
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	limiter := NewRateLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		if !limiter.Allow() {
+			t.Fatalf("request %d was limited with rate limiting disabled", i)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const referencedByAnotherResourceMsg = "referenced by another resource"
+
+// deleteRetryDelay is how long RetryDeleteWhileReferenced waits before retrying after
+// the given failed attempt: 10s before the first retry, increasing by 1s each
+// subsequent retry.
+var deleteRetryDelay = func(attempt int) time.Duration {
+	return time.Duration(10+attempt) * time.Second
+}
+
+// RetryError is returned by the retry helpers when they give up before the
+// API call succeeds, either because the attempts ran out or because the
+// context was cancelled.
+type RetryError struct {
+	// Attempts is the number of API calls made.
+	Attempts int
+	// Reason explains why the helper stopped retrying.
+	Reason string
+	// Err is the error returned by the last API call.
+	Err error
+	// Response is the last API response, with its body still readable.
+	Response *http.Response
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("API request stopped after %d attempt(s): %s", e.Attempts, e.Reason)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// Diagnostic describes the retry failure, including the last API error and
+// the decoded API response when there is one.
+func (e *RetryError) Diagnostic() diag.Diagnostic {
+	detail := fmt.Sprintf("Made %d attempt(s) and stopped because %s.", e.Attempts, e.Reason)
+	if e.Err != nil {
+		detail += "\nLast error: " + e.Err.Error()
+	}
+	if apiErr := DecodeAPIError(e.Response); apiErr != nil {
+		detail += "\nLast response: " + apiErr.Error()
+		if apiErr.RequestID != "" {
+			detail += "\nRequest ID: " + apiErr.RequestID
+		}
+	}
+	return diag.NewErrorDiagnostic(e.Error(), detail)
+}
+
+// SleepContext waits for d or until ctx is done, whichever happens first, and
+// returns the context error in the latter case.
+func SleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// RetryDeleteWhileReferenced retries a delete call that failed with a 400
+// because the resource is still referenced by another resource, which happens
+// while the API is still deleting the dependent resource. It also retries a
+// 500, which RetryTransport does not retry. Rate limits and the other transient
+// errors are already retried by RetryTransport, so any other error is
+// returned as is. It stops as soon as ctx is cancelled and returns a
+// *RetryError describing the attempts made when it gives up.
+func RetryDeleteWhileReferenced[T any](ctx context.Context, apiCall func() (T, *http.Response, error), maxRetries int) (T, *http.Response, error) {
+	var result T
+	var response *http.Response
+	var err error
+
+	for i := 0; i < maxRetries; i++ {
+		// Call the API function.
+		result, response, err = apiCall()
+
+		// Success.
+		if err == nil {
+			return result, response, nil
+		}
+
+		if ctx.Err() != nil {
+			return result, response, &RetryError{Attempts: i + 1, Reason: ctx.Err().Error(), Err: err, Response: response}
+		}
+
+		// Only retry a 500, or a 400 caused by the resource still being
+		// referenced.
+		if response == nil || response.StatusCode != http.StatusInternalServerError &&
+			!(response.StatusCode == http.StatusBadRequest && isReferencedByAnotherResource(response, err)) {
+			return result, response, err
+		}
+
+		if i == maxRetries-1 {
+			break
+		}
+
+		// Keep the body readable for the caller while releasing the connection.
+		bufferBody(response)
+
+		if sleepErr := SleepContext(ctx, deleteRetryDelay(i)); sleepErr != nil {
+			return result, response, &RetryError{Attempts: i + 1, Reason: sleepErr.Error(), Err: err, Response: response}
+		}
+	}
+
+	return result, response, &RetryError{
+		Attempts: maxRetries,
+		Reason:   "the maximum number of attempts was reached",
+		Err:      err,
+		Response: response,
+	}
+}
+
+// isReferencedByAnotherResource reports whether a delete failure was caused by
+// the resource still being referenced by another resource. It inspects both the
+// error and the response body, restoring the body so the caller can still read it.
+func isReferencedByAnotherResource(response *http.Response, err error) bool {
+	if err != nil && strings.Contains(strings.ToLower(err.Error()), referencedByAnotherResourceMsg) {
+		return true
+	}
+	if response == nil || response.Body == nil {
+		return false
+	}
+	bodyBytes := bufferBody(response)
+	return strings.Contains(strings.ToLower(string(bodyBytes)), referencedByAnotherResourceMsg)
+}
+
+// bufferBody reads and closes the response body, replacing it with an
+// in-memory copy so that it can still be read later.
+func bufferBody(response *http.Response) []byte {
+	if response == nil || response.Body == nil {
+		return nil
+	}
+	bodyBytes, _ := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	return bodyBytes
+}
@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func apiResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestRetryDeleteWhileReferencedStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	start := time.Now()
+	_, response, err := RetryDeleteWhileReferenced(ctx, func() (any, *http.Response, error) {
+		calls++
+		return nil, apiResponse(http.StatusBadRequest, `{"detail":"The object is referenced by another resource."}`), errors.New("400 Bad Request")
+	}, 5)
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("RetryDeleteWhileReferenced ignored the cancelled context and took %s", elapsed)
+	}
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("err = %v, want a *RetryError", err)
+	}
+	if retryErr.Attempts != 1 || calls != 1 {
+		t.Errorf("attempts = %d, calls = %d, want 1", retryErr.Attempts, calls)
+	}
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) || !strings.Contains(retryErr.Reason, "deadline exceeded") {
+		t.Errorf("reason = %q, want the context error", retryErr.Reason)
+	}
+
+	diagnostic := retryErr.Diagnostic()
+	if !strings.Contains(diagnostic.Detail(), "Made 1 attempt(s)") || !strings.Contains(diagnostic.Detail(), "referenced by another resource") {
+		t.Errorf("diagnostic detail = %q", diagnostic.Detail())
+	}
+	if body, _ := io.ReadAll(response.Body); string(body) != `{"detail":"The object is referenced by another resource."}` {
+		t.Errorf("response body = %q, want it still readable", body)
+	}
+}
+
+func TestRetryDeleteWhileReferencedDoesNotRetryOtherErrors(t *testing.T) {
+	calls := 0
+	_, response, err := RetryDeleteWhileReferenced(context.Background(), func() (any, *http.Response, error) {
+		calls++
+		return nil, apiResponse(http.StatusNotFound, ""), errors.New("404 Not Found")
+	}, 5)
+
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+	var retryErr *RetryError
+	if err == nil || errors.As(err, &retryErr) {
+		t.Errorf("err = %v, want the API error", err)
+	}
+	if response.StatusCode != http.StatusNotFound {
+		t.Errorf("status = %d, want 404", response.StatusCode)
+	}
+}
+
+// withoutDeleteRetryDelay makes RetryDeleteWhileReferenced retry immediately for the
+// rest of the test.
+func withoutDeleteRetryDelay(t *testing.T) {
+	t.Helper()
+	delay := deleteRetryDelay
+	deleteRetryDelay = func(int) time.Duration { return 0 }
+	t.Cleanup(func() { deleteRetryDelay = delay })
+}
+
+func TestRetryDeleteWhileReferencedRetries(t *testing.T) {
+	withoutDeleteRetryDelay(t)
+
+	tests := []struct {
+		name      string
+		responses []*http.Response
+		wantCalls int
+		wantErr   bool
+		wantRetry bool
+	}{
+		{
+			name:      "success",
+			responses: []*http.Response{apiResponse(http.StatusOK, "")},
+			wantCalls: 1,
+		},
+		{
+			// RetryTransport has already retried rate limits.
+			name:      "too many requests",
+			responses: []*http.Response{apiResponse(http.StatusTooManyRequests, "")},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			// RetryTransport does not retry a 500.
+			name: "internal server error",
+			responses: []*http.Response{
+				apiResponse(http.StatusInternalServerError, ""),
+				apiResponse(http.StatusOK, ""),
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "referenced by another resource",
+			responses: []*http.Response{
+				apiResponse(http.StatusBadRequest, `{"detail":"The object is referenced by another resource."}`),
+				apiResponse(http.StatusOK, ""),
+			},
+			wantCalls: 2,
+		},
+		{
+			name:      "other bad request",
+			responses: []*http.Response{apiResponse(http.StatusBadRequest, `{"detail":"invalid"}`)},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:      "no response",
+			responses: []*http.Response{nil},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name: "attempts exhausted",
+			responses: []*http.Response{
+				apiResponse(http.StatusBadRequest, `{"detail":"referenced by another resource"}`),
+				apiResponse(http.StatusBadRequest, `{"detail":"referenced by another resource"}`),
+				apiResponse(http.StatusBadRequest, `{"detail":"referenced by another resource"}`),
+			},
+			wantCalls: 3,
+			wantErr:   true,
+			wantRetry: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			_, response, err := RetryDeleteWhileReferenced(context.Background(), func() (any, *http.Response, error) {
+				response := tt.responses[calls]
+				calls++
+				if response == nil {
+					return nil, nil, errors.New("connection refused")
+				}
+				if response.StatusCode >= 400 {
+					return nil, response, errors.New(response.Status)
+				}
+				return nil, response, nil
+			}, 3)
+
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %t", err, tt.wantErr)
+			}
+			var retryErr *RetryError
+			if errors.As(err, &retryErr) != tt.wantRetry {
+				t.Errorf("err = %v, want a *RetryError: %t", err, tt.wantRetry)
+			}
+			if tt.wantRetry && retryErr.Attempts != tt.wantCalls {
+				t.Errorf("attempts = %d, want %d", retryErr.Attempts, tt.wantCalls)
+			}
+			if response != tt.responses[calls-1] {
+				t.Errorf("response = %v, want the last API response", response)
+			}
+		})
+	}
+}
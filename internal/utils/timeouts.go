@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Default operation timeouts, used when a resource has no timeouts block.
+// They bound the whole operation, including every retry of the requests it
+// sends.
+const (
+	DefaultCreateTimeout = 20 * time.Minute
+	DefaultReadTimeout   = 5 * time.Minute
+	DefaultUpdateTimeout = 20 * time.Minute
+	DefaultDeleteTimeout = 20 * time.Minute
+)
+
+type operationKey struct{}
+
+type operation struct {
+	name        string
+	description string
+	timeout     time.Duration
+}
+
+// WithTimeout returns a copy of ctx that is cancelled after timeout. name is
+// the timeouts attribute that configured it (create, read, update or delete)
+// and description says what the operation is waiting for; both are used by
+// AddTimeoutError.
+func WithTimeout(ctx context.Context, timeout time.Duration, name, description string) (context.Context, context.CancelFunc) {
+	ctx = context.WithValue(ctx, operationKey{}, operation{
+		name:        name,
+		description: description,
+		timeout:     timeout,
+	})
+	return context.WithTimeout(ctx, timeout)
+}
+
+// AddTimeoutError reports whether the operation bound to ctx by WithTimeout
+// ran out of time and, if so, adds a diagnostic saying what it was waiting
+// for. Callers should check it before inspecting the API response, which is
+// nil when the request was cancelled.
+func AddTimeoutError(ctx context.Context, diags *diag.Diagnostics) bool {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return false
+	}
+	op, ok := ctx.Value(operationKey{}).(operation)
+	if !ok {
+		diags.AddError("Operation timed out", "The operation did not finish before its deadline.")
+		return true
+	}
+	diags.AddError(
+		"Operation timed out",
+		fmt.Sprintf("Timed out after %s waiting for %s. Increase the %q value of the resource's timeouts block if the API needs longer.",
+			op.timeout, op.description, op.name),
+	)
+	return true
+}
+
+// NullTimeouts returns an unset timeouts block. State built from scratch, as
+// on import, must use it instead of the zero timeouts.Value, which carries no
+// attribute types and cannot be written to state.
+func NullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestAddTimeoutError(t *testing.T) {
+	ctx, cancel := WithTimeout(context.Background(), time.Millisecond, "create", "azion_bucket to be created")
+	defer cancel()
+
+	var diags diag.Diagnostics
+	if AddTimeoutError(ctx, &diags) {
+		t.Fatal("AddTimeoutError reported a timeout before the deadline")
+	}
+
+	<-ctx.Done()
+	if !AddTimeoutError(ctx, &diags) {
+		t.Fatal("AddTimeoutError did not report the expired deadline")
+	}
+	if len(diags) != 1 {
+		t.Fatalf("got %d diagnostics, want 1", len(diags))
+	}
+	detail := diags[0].Detail()
+	for _, want := range []string{"Timed out after 1ms", "azion_bucket to be created", `"create"`} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail %q does not mention %q", detail, want)
+		}
+	}
+}
+
+func TestAddTimeoutErrorIgnoresCancel(t *testing.T) {
+	ctx, cancel := WithTimeout(context.Background(), time.Minute, "read", "azion_bucket to be read")
+	cancel()
+
+	var diags diag.Diagnostics
+	if AddTimeoutError(ctx, &diags) || diags.HasError() {
+		t.Fatal("AddTimeoutError treated a cancelled context as a timeout")
+	}
+}
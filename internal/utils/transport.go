@@ -0,0 +1,171 @@
+package utils
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries = 5
+	DefaultMinBackoff = 1 * time.Second
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// DefaultRetryOnStatus lists the response status codes retried by default.
+var DefaultRetryOnStatus = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryTransport is an http.RoundTripper that retries requests failing with a
+// transient error. Idempotent requests are retried on network errors and on
+// any status in RetryOnStatus; non-idempotent requests are only retried on a
+// 429, since the API rejects those before doing any work.
+type RetryTransport struct {
+	Base          http.RoundTripper
+	MaxRetries    int
+	MinBackoff    time.Duration
+	MaxBackoff    time.Duration
+	RetryOnStatus []int
+}
+
+// NewRetryTransport wraps base with the default retry policy. A nil base uses
+// http.DefaultTransport.
+func NewRetryTransport(base http.RoundTripper) *RetryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RetryTransport{
+		Base:          base,
+		MaxRetries:    DefaultMaxRetries,
+		MinBackoff:    DefaultMinBackoff,
+		MaxBackoff:    DefaultMaxBackoff,
+		RetryOnStatus: DefaultRetryOnStatus,
+	}
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attemptReq := req
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			attemptReq = req.Clone(withAttempt(req.Context(), attempt))
+			if req.Body != nil {
+				// Each attempt needs a fresh copy of the request body.
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attemptReq.Body = body
+			}
+		}
+
+		response, err := t.Base.RoundTrip(attemptReq)
+		if attempt >= t.MaxRetries || !t.shouldRetry(req, response, err) {
+			return response, err
+		}
+
+		wait := t.backoff(attempt, response)
+		fields := map[string]any{
+			"http_method": req.Method,
+			"http_url":    req.URL.Redacted(),
+			"attempt":     attempt + 1,
+			"wait_ms":     wait.Milliseconds(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["http_status"] = response.StatusCode
+		}
+		tflog.Debug(req.Context(), "Retrying Azion API request", fields)
+
+		if response != nil {
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 4096))
+			response.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *RetryTransport) shouldRetry(req *http.Request, response *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		// The body cannot be replayed.
+		return false
+	}
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+	if !slices.Contains(t.RetryOnStatus, response.StatusCode) {
+		return false
+	}
+	return response.StatusCode == http.StatusTooManyRequests || isIdempotent(req.Method)
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header takes precedence over the exponential backoff; both are capped at
+// MaxBackoff.
+func (t *RetryTransport) backoff(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if wait, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+			return min(wait, t.MaxBackoff)
+		}
+	}
+
+	wait := t.MinBackoff
+	for i := 0; i < attempt && wait < t.MaxBackoff; i++ {
+		wait *= 2
+	}
+	wait = min(wait, t.MaxBackoff)
+	if wait <= 0 {
+		return 0
+	}
+
+	// Equal jitter: keep half of the backoff and randomise the rest so that
+	// concurrent resources do not retry in lockstep.
+	half := wait / 2
+	return half + rand.N(wait-half+1)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an
+// HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestRetryTransport() *RetryTransport {
+	transport := NewRetryTransport(nil)
+	transport.MaxRetries = 3
+	transport.MinBackoff = time.Millisecond
+	transport.MaxBackoff = 5 * time.Millisecond
+	return transport
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		wantStatus   int
+		wantAttempts int32
+	}{
+		{"success is not retried", http.MethodGet, []int{200}, 200, 1},
+		{"429 then success", http.MethodGet, []int{429, 200}, 200, 2},
+		{"503 on GET is retried", http.MethodGet, []int{503, 502, 200}, 200, 3},
+		{"503 on POST is not retried", http.MethodPost, []int{503, 200}, 503, 1},
+		{"429 on POST is retried", http.MethodPost, []int{429, 200}, 200, 2},
+		{"client errors are not retried", http.MethodGet, []int{400, 200}, 400, 1},
+		{"gives up after max retries", http.MethodGet, []int{504, 504, 504, 504, 504}, 504, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				body, _ := io.ReadAll(r.Body)
+				if r.Method == http.MethodPost && string(body) != `{"name":"bucket"}` {
+					t.Errorf("attempt %d: body = %q, want it replayed", n, body)
+				}
+				w.WriteHeader(tt.statuses[n-1])
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: newTestRetryTransport()}
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader(`{"name":"bucket"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			response, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			response.Body.Close()
+
+			if response.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", response.StatusCode, tt.wantStatus)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := NewRetryTransport(nil)
+	transport.MinBackoff = time.Second
+	transport.MaxBackoff = 8 * time.Second
+
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second} {
+		got := transport.backoff(attempt, nil)
+		if got < want/2 || got > want {
+			t.Errorf("attempt %d: backoff = %s, want within [%s, %s]", attempt, got, want/2, want)
+		}
+	}
+
+	response := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if got := transport.backoff(0, response); got != 3*time.Second {
+		t.Errorf("Retry-After backoff = %s, want 3s", got)
+	}
+
+	response.Header.Set("Retry-After", "60")
+	if got := transport.backoff(0, response); got != transport.MaxBackoff {
+		t.Errorf("capped Retry-After backoff = %s, want %s", got, transport.MaxBackoff)
+	}
+}
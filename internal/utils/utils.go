@@ -1,19 +1,12 @@
 package utils
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"math"
-	"net/http"
 	"strconv"
-	"strings"
-	"time"
 
-	"github.com/aziontech/azionapi-go-sdk/edgeapplications"
-	"github.com/aziontech/azionapi-go-sdk/edgefunctionsinstance_edgefirewall"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,80 +34,85 @@ func SliceIntTypeToList(slice []types.Int64) types.List {
 	return types.ListValueMust(types.Int64Type, integers)
 }
 
+// SliceStringTypeToSet returns a set of the distinct strings in slice, empty
+// when slice is.
 func SliceStringTypeToSet(slice []types.String) types.Set {
 	if len(slice) == 0 {
-		return types.SetNull(types.StringType)
-	}
-	strings := []attr.Value{}
-	for _, value := range slice {
-		strings = append(strings, value)
+		return types.SetValueMust(types.StringType, nil)
 	}
-	return types.SetValueMust(types.StringType, strings)
+	return types.SetValueMust(types.StringType, uniqueStrings(slice))
 }
 
+// SliceStringTypeToSetOrNull returns a set of the distinct strings in slice,
+// or a null set when slice is empty.
 func SliceStringTypeToSetOrNull(slice []types.String) types.Set {
 	if len(slice) == 0 {
-		return types.SetValueMust(types.StringType, nil)
+		return types.SetNull(types.StringType)
 	}
+	return types.SetValueMust(types.StringType, uniqueStrings(slice))
+}
+
+// uniqueStrings returns the distinct values of slice, in order, as Terraform
+// rejects sets with duplicate elements.
+func uniqueStrings(slice []types.String) []attr.Value {
+	seen := make(map[types.String]bool, len(slice))
 	strings := []attr.Value{}
 	for _, value := range slice {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
 		strings = append(strings, value)
 	}
-	return types.SetValueMust(types.StringType, strings)
+	return strings
 }
 
 func ConvertStringToInterface(jsonArgs string) (interface{}, error) {
 	var data map[string]interface{}
 	err := json.Unmarshal([]byte(jsonArgs), &data)
 	if err != nil {
-		fmt.Println("Error:", err)
 		return nil, err
 	}
 	return data, err
 }
 
-func UnmarshallJsonArgs(jsonArgs string) (edgeapplications.ApplicationCreateInstanceRequestArgs, error) {
-	var data edgeapplications.ApplicationCreateInstanceRequestArgs
-	args := make(map[string]interface{})
-	err := json.Unmarshal([]byte(jsonArgs), &data)
-	if err != nil {
-		fmt.Println("Error:", err)
-		data.MapmapOfStringAny = &args
-		return data, nil
+// FunctionArgs decodes the JSON args of a function instance. The API accepts
+// an object or an array of objects; anything else is sent as an empty object.
+func FunctionArgs(jsonArgs string) any {
+	var object map[string]any
+	if err := json.Unmarshal([]byte(jsonArgs), &object); err == nil && object != nil {
+		return object
 	}
-	return data, nil
-}
-
-func UnmarshallJsonArgsFirewall(jsonArgs string) (edgefunctionsinstance_edgefirewall.EdgeFunctionsInstanceJsonArgs, error) {
-	var data edgefunctionsinstance_edgefirewall.EdgeFunctionsInstanceJsonArgs
-	args := make(map[string]interface{})
-	err := json.Unmarshal([]byte(jsonArgs), &data)
-	if err != nil {
-		fmt.Println("Error:", err)
-		data.MapmapOfStringAny = &args
-		return data, nil
+	var objects []map[string]any
+	if err := json.Unmarshal([]byte(jsonArgs), &objects); err == nil && objects != nil {
+		return objects
 	}
-	return data, nil
+	return map[string]any{}
 }
 
 func ConvertInterfaceToString(jsonArgs interface{}) (string, error) {
 	jsonArgsStr, err := json.Marshal(jsonArgs)
 	if err != nil {
-		fmt.Println("Error:", err)
-		return "{}", nil
+		return "{}", err
 	}
 
 	return string(jsonArgsStr), err
 }
 
+// ConvertInterfaceToFloat64List converts a decoded JSON array of numbers. It
+// returns nil when listInt is not an array or holds anything but numbers.
 func ConvertInterfaceToFloat64List(listInt interface{}) []types.Float64 {
-	iListInt := listInt.([]interface{})
+	iListInt, ok := listInt.([]interface{})
+	if !ok {
+		return nil
+	}
 	var integers []types.Float64
 	for _, v := range iListInt {
-		if _, ok := v.(float64); !ok {
+		f, ok := v.(float64)
+		if !ok {
 			return nil
 		}
-		integers = append(integers, types.Float64Value(v.(float64)))
+		integers = append(integers, types.Float64Value(f))
 	}
 	return integers
 }
@@ -187,96 +185,3 @@ func ExceedsValidRange(resp any, vl any) {
 		return
 	}
 }
-
-func SleepAfter429(response *http.Response) error {
-	timeToSleep := response.Header.Get("retry-after")
-	num, err := strconv.Atoi(timeToSleep)
-	if err != nil {
-		return err
-	}
-	time.Sleep((time.Duration(num) + 1) * time.Second)
-	return nil
-}
-
-// RetryOn429 retries an API call if the response status is 429.
-func RetryOn429[T any](apiCall func() (T, *http.Response, error), maxRetries int) (T, *http.Response, error) {
-	var result T
-	var response *http.Response
-	var err error
-
-	for i := 0; i < maxRetries; i++ {
-		// Call the API function
-		result, response, err = apiCall()
-
-		// If no error and not a 429, return successfully
-		if err == nil && response.StatusCode != http.StatusTooManyRequests {
-			return result, response, nil
-		}
-
-		// If error is not 429, return immediately
-		if response.StatusCode != http.StatusTooManyRequests {
-			return result, response, err
-		}
-
-		// Sleep before retrying
-		if sleepErr := SleepAfter429(response); sleepErr != nil {
-			return result, response, sleepErr
-		}
-	}
-
-	return result, response, errors.New("max retries exceeded for API request")
-}
-
-const referencedByAnotherResourceMsg = "referenced by another resource"
-
-func RetryOn429Delete[T any](apiCall func() (T, *http.Response, error), maxRetries int) (T, *http.Response, error) {
-	var result T
-	var response *http.Response
-	var err error
-
-	for i := 0; i < maxRetries; i++ {
-		// Call the API function.
-		result, response, err = apiCall()
-
-		// Success.
-		if err == nil {
-			return result, response, nil
-		}
-
-		// No response to inspect, return immediately.
-		if response == nil {
-			return result, response, err
-		}
-
-		// Only retry on a 429, a 500, or a 400 caused by the resource still being referenced.
-		if response.StatusCode != http.StatusTooManyRequests &&
-			response.StatusCode != http.StatusInternalServerError &&
-			!(response.StatusCode == http.StatusBadRequest && isReferencedByAnotherResource(response, err)) {
-			return result, response, err
-		}
-
-		// Sleep 10s before the first retry, increasing by 1s each subsequent retry.
-		time.Sleep(time.Duration(10+i) * time.Second)
-	}
-
-	return result, response, errors.New("max retries exceeded for API request")
-}
-
-// isReferencedByAnotherResource reports whether a delete failure was caused by
-// the resource still being referenced by another resource. It inspects both the
-// error and the response body, restoring the body so the caller can still read it.
-func isReferencedByAnotherResource(response *http.Response, err error) bool {
-	if err != nil && strings.Contains(strings.ToLower(err.Error()), referencedByAnotherResourceMsg) {
-		return true
-	}
-	if response == nil || response.Body == nil {
-		return false
-	}
-	bodyBytes, readErr := io.ReadAll(response.Body)
-	if readErr != nil {
-		return false
-	}
-	// Restore the body so callers can still read it for error reporting.
-	response.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-	return strings.Contains(strings.ToLower(string(bodyBytes)), referencedByAnotherResourceMsg)
-}
@@ -0,0 +1,295 @@
+package utils
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSliceStringTypeToSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		slice    []types.String
+		wantNull bool
+		wantLen  int
+	}{
+		{name: "nil", slice: nil},
+		{name: "empty", slice: []types.String{}},
+		{name: "values", slice: []types.String{types.StringValue("BR"), types.StringValue("US")}, wantLen: 2},
+		{name: "duplicates", slice: []types.String{types.StringValue("BR"), types.StringValue("BR")}, wantLen: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := SliceStringTypeToSet(tt.slice)
+			if set.IsNull() {
+				t.Fatalf("SliceStringTypeToSet(%v) is null, want a set", tt.slice)
+			}
+			if got := len(set.Elements()); got != tt.wantLen {
+				t.Errorf("SliceStringTypeToSet(%v) has %d elements, want %d", tt.slice, got, tt.wantLen)
+			}
+
+			orNull := SliceStringTypeToSetOrNull(tt.slice)
+			if orNull.IsNull() != (tt.wantLen == 0) {
+				t.Errorf("SliceStringTypeToSetOrNull(%v).IsNull() = %t, want %t", tt.slice, orNull.IsNull(), tt.wantLen == 0)
+			}
+			if !orNull.IsNull() && !orNull.Equal(set) {
+				t.Errorf("SliceStringTypeToSetOrNull(%v) = %v, want %v", tt.slice, orNull, set)
+			}
+		})
+	}
+}
+
+func TestSliceTypeToList(t *testing.T) {
+	strings := SliceStringTypeToList(nil)
+	if strings.IsNull() || len(strings.Elements()) != 0 {
+		t.Errorf("SliceStringTypeToList(nil) = %v, want an empty list", strings)
+	}
+	strings = SliceStringTypeToList([]types.String{types.StringValue("a"), types.StringValue("a")})
+	if len(strings.Elements()) != 2 {
+		t.Errorf("SliceStringTypeToList kept %d elements, want 2", len(strings.Elements()))
+	}
+
+	integers := SliceIntTypeToList(nil)
+	if integers.IsNull() || len(integers.Elements()) != 0 {
+		t.Errorf("SliceIntTypeToList(nil) = %v, want an empty list", integers)
+	}
+	integers = SliceIntTypeToList([]types.Int64{types.Int64Value(1), types.Int64Value(2)})
+	if len(integers.Elements()) != 2 {
+		t.Errorf("SliceIntTypeToList kept %d elements, want 2", len(integers.Elements()))
+	}
+}
+
+func TestConvertInterfaceToFloat64List(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  []types.Float64
+	}{
+		{name: "nil", value: nil},
+		{name: "not a slice", value: "1,2"},
+		{name: "number", value: float64(1)},
+		{name: "float64 slice", value: []float64{1, 2}},
+		{name: "empty", value: []any{}},
+		{name: "numbers", value: []any{float64(1), 2.5}, want: []types.Float64{types.Float64Value(1), types.Float64Value(2.5)}},
+		{name: "mixed", value: []any{float64(1), "2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertInterfaceToFloat64List(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConvertInterfaceToFloat64List(%#v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertFloat64ToInterface(t *testing.T) {
+	got, err := ConvertFloat64ToInterface([]types.Float64{types.Float64Value(1), types.Float64Value(2.5)})
+	if err != nil {
+		t.Fatalf("ConvertFloat64ToInterface() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, []float64{1, 2.5}) {
+		t.Errorf("ConvertFloat64ToInterface() = %v, want [1 2.5]", got)
+	}
+}
+
+func TestConvertStringToInterface(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    any
+		wantErr bool
+	}{
+		{name: "object", args: `{"key":"value"}`, want: map[string]any{"key": "value"}},
+		{name: "empty object", args: `{}`, want: map[string]any{}},
+		{name: "array", args: `[{"key":"value"}]`, wantErr: true},
+		{name: "invalid", args: `{`, wantErr: true},
+		{name: "empty", args: ``, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertStringToInterface(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConvertStringToInterface(%q) error = %v, wantErr %t", tt.args, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConvertStringToInterface(%q) = %#v, want %#v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFunctionArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+		want any
+	}{
+		{name: "object", args: `{"key":"value"}`, want: map[string]any{"key": "value"}},
+		{name: "array of objects", args: `[{"key":"value"}]`, want: []map[string]any{{"key": "value"}}},
+		{name: "null", args: `null`, want: map[string]any{}},
+		{name: "string", args: `"value"`, want: map[string]any{}},
+		{name: "array of strings", args: `["value"]`, want: map[string]any{}},
+		{name: "invalid", args: `{`, want: map[string]any{}},
+		{name: "empty", args: ``, want: map[string]any{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FunctionArgs(tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FunctionArgs(%q) = %#v, want %#v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertInterfaceToString(t *testing.T) {
+	got, err := ConvertInterfaceToString(map[string]any{"key": "value"})
+	if err != nil || got != `{"key":"value"}` {
+		t.Errorf("ConvertInterfaceToString() = %q, %v", got, err)
+	}
+	got, err = ConvertInterfaceToString(math.NaN())
+	if err == nil || got != "{}" {
+		t.Errorf("ConvertInterfaceToString(NaN) = %q, %v, want {} and an error", got, err)
+	}
+}
+
+func TestAtoiNoError(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int32
+		wantErr bool
+	}{
+		{value: "42", want: 42},
+		{value: "-2147483648", want: math.MinInt32},
+		{value: "2147483647", want: math.MaxInt32},
+		{value: "2147483648", wantErr: true},
+		{value: "-2147483649", wantErr: true},
+		{value: "abc", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			resp := &resource.ReadResponse{}
+			if got := AtoiNoError(tt.value, resp); got != tt.want {
+				t.Errorf("AtoiNoError(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("AtoiNoError(%q) diagnostics = %v, wantErr %t", tt.value, resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckInt64toInt32Security(t *testing.T) {
+	tests := []struct {
+		value   int64
+		wantErr bool
+	}{
+		{value: 0},
+		{value: math.MaxInt32},
+		{value: math.MinInt32},
+		{value: math.MaxInt32 + 1, wantErr: true},
+		{value: math.MinInt32 - 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := CheckInt64toInt32Security(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CheckInt64toInt32Security(%d) error = %v, wantErr %t", tt.value, err, tt.wantErr)
+		}
+		if !tt.wantErr && int64(got) != tt.value {
+			t.Errorf("CheckInt64toInt32Security(%d) = %d", tt.value, got)
+		}
+	}
+}
+
+func TestExceedsValidRange(t *testing.T) {
+	resp := &resource.CreateResponse{}
+	ExceedsValidRange(resp, int64(math.MaxInt64))
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("ExceedsValidRange() added no error")
+	}
+
+	// Unsupported responses are ignored.
+	ExceedsValidRange(&resource.ReadResponse{}, nil)
+}
+
+func FuzzConvertInterfaceToFloat64List(f *testing.F) {
+	for _, seed := range []string{`[1,2.5]`, `[]`, `["1"]`, `1`, `null`, `{"a":1}`, `[1,[2]]`} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data string) {
+		var value any
+		if err := json.Unmarshal([]byte(data), &value); err != nil {
+			return
+		}
+		list := ConvertInterfaceToFloat64List(value)
+		if list == nil {
+			return
+		}
+		back, err := ConvertFloat64ToInterface(list)
+		if err != nil {
+			t.Fatalf("ConvertFloat64ToInterface(%v) error = %v", list, err)
+		}
+		for i, v := range back.([]float64) {
+			if v != value.([]any)[i] {
+				t.Errorf("element %d = %v, want %v", i, v, value.([]any)[i])
+			}
+		}
+	})
+}
+
+func FuzzFunctionArgs(f *testing.F) {
+	for _, seed := range []string{`{"key":"value"}`, `[{"key":1}]`, `[]`, `null`, `"x"`, `{`, ``} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data string) {
+		args := FunctionArgs(data)
+		switch args.(type) {
+		case map[string]any, []map[string]any:
+		default:
+			t.Fatalf("FunctionArgs(%q) = %T, want an object or an array of objects", data, args)
+		}
+		if _, err := json.Marshal(args); err != nil {
+			t.Errorf("FunctionArgs(%q) cannot be encoded: %v", data, err)
+		}
+	})
+}
+
+func FuzzConvertStringToInterface(f *testing.F) {
+	for _, seed := range []string{`{"key":"value"}`, `{"nested":{"list":[1,2]}}`, `[]`, `{`, ``} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data string) {
+		value, err := ConvertStringToInterface(data)
+		if err != nil {
+			return
+		}
+		encoded, err := ConvertInterfaceToString(value)
+		if err != nil {
+			t.Fatalf("ConvertInterfaceToString(%v) error = %v", value, err)
+		}
+		again, err := ConvertStringToInterface(encoded)
+		if err != nil {
+			t.Fatalf("ConvertStringToInterface(%q) error = %v", encoded, err)
+		}
+		if !reflect.DeepEqual(value, again) {
+			t.Errorf("round trip of %q = %#v, want %#v", data, again, value)
+		}
+	})
+}
+
+func FuzzAtoiNoError(f *testing.F) {
+	for _, seed := range []string{"0", "-1", "2147483647", "2147483648", "9223372036854775808", "1e3", "abc", ""} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, value string) {
+		resp := &resource.ReadResponse{}
+		got := AtoiNoError(value, resp)
+		if resp.Diagnostics.HasError() && got != 0 {
+			t.Errorf("AtoiNoError(%q) = %d with an error, want 0", value, got)
+		}
+	})
+}